@@ -50,6 +50,9 @@ type MCPServerConfig struct {
 
 	// Content window size
 	ContentWindowSize int
+
+	// GraphQLMaxRetries is how many times a rate-limited GraphQL request is retried
+	GraphQLMaxRetries int
 }
 
 const stdioServerLogPrefix = "stdioserver"
@@ -70,9 +73,14 @@ func NewMCPServer(cfg MCPServerConfig) (*server.MCPServer, error) {
 	// We're using NewEnterpriseClient here unconditionally as opposed to NewClient because we already
 	// did the necessary API host parsing so that github.com will return the correct URL anyway.
 	gqlHTTPClient := &http.Client{
-		Transport: &bearerAuthTransport{
-			transport: http.DefaultTransport,
-			token:     cfg.Token,
+		Transport: &github.RawGraphQLTransport{
+			Transport: &github.RetryGraphQLTransport{
+				Transport: &bearerAuthTransport{
+					transport: http.DefaultTransport,
+					token:     cfg.Token,
+				},
+				MaxRetries: cfg.GraphQLMaxRetries,
+			},
 		},
 	} // We're going to wrap the Transport later in beforeInit
 	gqlClient := githubv4.NewEnterpriseClient(apiHost.graphqlURL.String(), gqlHTTPClient)
@@ -186,6 +194,9 @@ type StdioServerConfig struct {
 
 	// Content window size
 	ContentWindowSize int
+
+	// GraphQLMaxRetries is how many times a rate-limited GraphQL request is retried
+	GraphQLMaxRetries int
 }
 
 // RunStdioServer is not concurrent safe.
@@ -205,6 +216,7 @@ func RunStdioServer(cfg StdioServerConfig) error {
 		ReadOnly:          cfg.ReadOnly,
 		Translator:        t,
 		ContentWindowSize: cfg.ContentWindowSize,
+		GraphQLMaxRetries: cfg.GraphQLMaxRetries,
 	})
 	if err != nil {
 		return fmt.Errorf("failed to create MCP server: %w", err)
@@ -149,9 +149,11 @@ func githubv4InputStructToMap(s any) (map[string]any, error) {
 // This client does not currently provide a mechanism for out-of-band errors e.g. returning a 500,
 // and errors are constrained to GQL errors returned in the response body with a 200 status code.
 func NewMockedHTTPClient(ms ...Matcher) *http.Client {
-	matchers := make(map[string]Matcher, len(ms))
+	// Several matchers may share the same query and differ only by variables, e.g. consecutive
+	// pages of a paginated query or the same mutation applied to different inputs.
+	matchers := make(map[string][]Matcher, len(ms))
 	for _, m := range ms {
-		matchers[m.Request] = m
+		matchers[m.Request] = append(matchers[m.Request], m)
 	}
 
 	mux := http.NewServeMux()
@@ -168,25 +170,24 @@ func NewMockedHTTPClient(ms ...Matcher) *http.Client {
 		}
 		defer func() { _ = r.Body.Close() }()
 
-		matcher, ok := matchers[gqlRequest.Query]
+		candidates, ok := matchers[gqlRequest.Query]
 		if !ok {
 			http.Error(w, fmt.Sprintf("no matcher found for query %s", gqlRequest.Query), http.StatusNotFound)
 			return
 		}
 
-		if len(gqlRequest.Variables) > 0 {
-			if len(gqlRequest.Variables) != len(matcher.Variables) {
-				http.Error(w, "variables do not have the same length", http.StatusBadRequest)
-				return
-			}
-
-			for k, v := range matcher.Variables {
-				if !objectsAreEqualValues(v, gqlRequest.Variables[k]) {
-					http.Error(w, "variable does not match", http.StatusBadRequest)
-					return
-				}
+		var matcher Matcher
+		var mismatch string
+		for _, candidate := range candidates {
+			if mismatch = variablesMismatch(candidate, gqlRequest); mismatch == "" {
+				matcher = candidate
+				break
 			}
 		}
+		if mismatch != "" {
+			http.Error(w, mismatch, http.StatusBadRequest)
+			return
+		}
 
 		responseBody, err := json.Marshal(matcher.Response)
 		if err != nil {
@@ -204,6 +205,26 @@ func NewMockedHTTPClient(ms ...Matcher) *http.Client {
 	}}
 }
 
+// variablesMismatch returns a description of why the request variables do not match the matcher,
+// or an empty string if they match.
+func variablesMismatch(matcher Matcher, req gqlRequest) string {
+	if len(req.Variables) == 0 {
+		return ""
+	}
+
+	if len(req.Variables) != len(matcher.Variables) {
+		return "variables do not have the same length"
+	}
+
+	for k, v := range matcher.Variables {
+		if !objectsAreEqualValues(v, req.Variables[k]) {
+			return "variable does not match"
+		}
+	}
+
+	return ""
+}
+
 type gqlRequest struct {
 	Query     string         `json:"query"`
 	Variables map[string]any `json:"variables,omitempty"`
@@ -38,6 +38,12 @@ func objectsAreEqualValues(expected, actual any) bool {
 		return true
 	}
 
+	// There is a modification to compare non-nil pointers by the value they point to. Optional variables
+	// such as pagination cursors are provided as pointers, but the request body only contains the value.
+	if v := reflect.ValueOf(expected); v.Kind() == reflect.Ptr && !v.IsNil() {
+		return objectsAreEqualValues(v.Elem().Interface(), actual)
+	}
+
 	expectedValue := reflect.ValueOf(expected)
 	actualValue := reflect.ValueOf(actual)
 	if !expectedValue.IsValid() || !actualValue.IsValid() {
@@ -1,16 +1,56 @@
 /*
  * UNDERSTANDING: GitHub Projects v2 API integration for MCP Server
  * DEPENDENCIES: githubv4 GraphQL client, mapstructure for parameter decoding
- * EXPORTS: CreateProject, AddItemToProject, ListUserProjects, UpdateProjectItemStatus, LinkProjectToRepository, UnlinkProjectFromRepository tools
+ * EXPORTS: CreateProject, AddItemToProject, ListUserProjects, UpdateProjectItemStatus, LinkProjectToRepository, UnlinkProjectFromRepository,
+ *          CreateProjectFromTemplate, GetProjectFlowMetrics, ListProjectItems, UpdateProjectIterationSettings,
+ *          AddDiscussionToProject, GetProjectFieldUsage, CopyProjectFieldOptions, RenameProjectField,
+ *          GetProjectItemVisibleViews, SetProjectItemsTextField, GetProjectItemAndContentIDs,
+ *          SetProjectItemSingleSelect, ListProjectItemsWithAddedDate, DeleteProjectFieldsByPattern,
+ *          ListProjectsForOwners, DefaultProjectItemStatus, GetProjectDescription, FindStaleProjectItems,
+ *          SetProjectItemsAssignees, GetProjectItemsByRepository, ValidateProjectItemsAgainstSchema,
+ *          ListProjectItemsPage, GetProjectStatusOrder, AddRepositoryIssuesToProject, FindMultiProjectItems,
+ *          ExportProject, ImportProject, ListRecentlyCompletedProjectItems, SetProjectItemRepository,
+ *          GetProjectItemChecklistProgress, UpdateProjectItemsStatus, ApplyProjectStatusMap,
+ *          SetProjectWorkflowEnabled, GetProjectAssigneeWorkload, GetProjectFields,
+ *          SyncProjectItemStatusToIssueState, CreateProjectForRepository, GetProjectsViewerPermissions,
+ *          FindProjectItemsWithoutPR, ClearProjectItemField, ConvertAllDraftsToIssues,
+ *          GetProjectIterationBurndown, UpdateProject, GetProjectField, DiffProjectSchemas, AddDraftIssue,
+ *          ConvertDraftIssueToIssue, ListProjectItemsDueSoon, ArchiveProjectItem, UnarchiveProjectItem,
+ *          DeleteProjectItem, AddItemsToProjectWithStatus, CreateProjectField, GetProjectItem,
+ *          UpdateProjectField, DeleteProjectField, GetRepositoryProjectID, ListProjectViews, GetProject,
+ *          GetProjectItemIndex, ListOrgProjects, GetProjectItemDevLinks, AddItemsToProject,
+ *          SetProjectItemBlockedBy, EstimateProjectItemFromSubIssues, GetProjectItemStatus,
+ *          FindProjectItemsWithInvalidRepo, PlanProjectViewCopy, SetProjectItemPosition,
+ *          CreateProjectStatusUpdate, ListProjectStatusUpdates tools;
+ *          RawGraphQLTransport and RetryGraphQLTransport for the GraphQL client
  * INTEGRATION: Fills the gap between GitHub MCP Server and Projects v2 GraphQL API
  */
 package github
 
 import (
+	"bytes"
+	"cmp"
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"maps"
+	"math"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
 
+	ghErrors "github.com/github/github-mcp-server/pkg/errors"
 	"github.com/github/github-mcp-server/pkg/translations"
 	"github.com/go-viper/mapstructure/v2"
 	"github.com/mark3labs/mcp-go/mcp"
@@ -19,515 +59,10960 @@ import (
 )
 
 // UNDERSTANDING: Create a new GitHub Projects v2 board programmatically
-// EXPECTS: owner_id (GitHub user/org node ID), title (project name)
+// EXPECTS: owner_id (GitHub user/org node ID) or owner (login), title (project name)
 // RETURNS: New project details including ID for immediate use
 // INTEGRATION: Foundational tool enabling full automation workflow from project creation
 func CreateProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
 	return mcp.NewTool("create_project",
-		mcp.WithDescription(t("TOOL_CREATE_PROJECT_DESCRIPTION", "Create a new GitHub Projects v2 board. This is the foundational tool that enables full project automation - create the board first, then add issues and manage workflows.")),
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_DESCRIPTION", "Create a new GitHub Projects v2 board. This is the foundational tool that enables full project automation - create the board first, then add issues and manage workflows.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PROJECT_USER_TITLE", "Create project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_id",
+				mcp.Description("GitHub node ID of the user or organization who will own the project (use get_me to find your user ID). Required unless owner is given"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Login of the user or organization who will own the project, as an alternative to owner_id"),
+			),
+			mcp.WithString("owner_type",
+				mcp.Description("Whether owner is a user or an organization. Detected automatically when omitted"),
+				mcp.Enum("user", "organization"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title/name for the new project"),
+			),
+			mcp.WithString("description",
+				mcp.Description("Optional description for the project"),
+			),
+			mcp.WithBoolean("validate_owner",
+				mcp.Description("Check that owner_id is a user or organization before creating the project, for a clearer error when e.g. a repository ID is passed"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				OwnerID       string  `mapstructure:"owner_id"`
+				Owner         string  `mapstructure:"owner"`
+				OwnerType     string  `mapstructure:"owner_type"`
+				Title         string  `mapstructure:"title"`
+				Description   *string `mapstructure:"description"`
+				ValidateOwner bool    `mapstructure:"validate_owner"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (params.OwnerID == "") == (params.Owner == "") {
+				return mcp.NewToolResultError("exactly one of owner_id or owner must be provided"), nil
+			}
+
+			// UNDERSTANDING: Get GraphQL client following existing patterns
+			// VERIFIED: Same pattern used in AddItemToProject and other GraphQL tools
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// UNDERSTANDING: A login is resolved through the shared owner cache; the result is already known to be a user or organization
+			if params.Owner != "" {
+				owner, err := resolveOwnerID(ctx, client, params.Owner, params.OwnerType)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				params.OwnerID = owner.ID
+			}
+
+			// UNDERSTANDING: createProjectV2 reports a wrong owner type obscurely, so optionally check it up front
+			if params.ValidateOwner && params.Owner == "" {
+				if err := validateProjectOwner(ctx, client, params.OwnerID); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			// UNDERSTANDING: Execute createProjectV2 mutation following GitHub's Projects v2 API
+			// EXPECTS: GitHub node ID for owner, project title, optional description
+			// RETURNS: Complete project details including ID for immediate use with other tools
+			// INTEGRATION: Foundational mutation that enables full project automation workflow
+			var createProjectMutation struct {
+				CreateProjectV2 struct {
+					ProjectV2 struct {
+						ID               githubv4.ID
+						Number           githubv4.Int
+						Title            githubv4.String
+						URL              githubv4.String
+						ShortDescription githubv4.String
+						CreatedAt        githubv4.DateTime
+					}
+				} `graphql:"createProjectV2(input: $input)"`
+			}
+
+			// UNDERSTANDING: Prepare input following GitHub's CreateProjectV2Input schema
+			// VERIFIED: Based on GitHub's official API documentation and examples
+			input := githubv4.CreateProjectV2Input{
+				OwnerID: githubv4.ID(params.OwnerID),
+				Title:   githubv4.String(params.Title),
+			}
+
+			if err := client.Mutate(
+				ctx,
+				&createProjectMutation,
+				input,
+				nil,
+			); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to create project", err), nil
+			}
+			project := createProjectMutation.CreateProjectV2.ProjectV2
+
+			// UNDERSTANDING: createProjectV2 has no description input, so it is set with a follow-up updateProjectV2
+			description := project.ShortDescription
+			if params.Description != nil {
+				var updateProjectMutation struct {
+					UpdateProjectV2 struct {
+						ProjectV2 struct {
+							ID               githubv4.ID
+							ShortDescription githubv4.String
+						}
+					} `graphql:"updateProjectV2(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &updateProjectMutation, githubv4.UpdateProjectV2Input{
+					ProjectID:        project.ID,
+					ShortDescription: githubv4.NewString(githubv4.String(*params.Description)),
+				}, nil); err != nil {
+					// VERIFIED: The project already exists at this point, so report its ID with the failure
+					return mcp.NewToolResultError(fmt.Sprintf("project %v was created but setting its description failed: %v", project.ID, err)), nil
+				}
+				description = updateProjectMutation.UpdateProjectV2.ProjectV2.ShortDescription
+			}
+
+			// UNDERSTANDING: Return comprehensive project details for immediate use
+			// INTEGRATION: Project ID can be used immediately with AddItemToProject tool
+			response := map[string]interface{}{
+				"success":        true,
+				"message":        "Project created successfully",
+				"project_id":     project.ID,
+				"project_number": int(project.Number),
+				"title":          project.Title,
+				"url":            project.URL,
+				"owner_id":       params.OwnerID,
+				"description":    description,
+				"created_at":     project.CreatedAt,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// decodeProjectParams decodes a tool request's arguments into params, trimming whitespace from ID arguments.
+// UNDERSTANDING: IDs pasted from the web UI often carry stray spaces or newlines, which GitHub rejects as unknown IDs;
+// every argument named *_id, and every entry of an argument named *_ids, is trimmed
+func decodeProjectParams(request mcp.CallToolRequest, params interface{}) error {
+	args := request.GetArguments()
+	normalized := make(map[string]interface{}, len(args))
+	for key, value := range args {
+		switch {
+		case strings.HasSuffix(key, "_id"):
+			if id, ok := value.(string); ok {
+				value = strings.TrimSpace(id)
+			}
+		case strings.HasSuffix(key, "_ids"):
+			if ids, ok := value.([]interface{}); ok {
+				trimmed := make([]interface{}, len(ids))
+				for i, id := range ids {
+					if s, ok := id.(string); ok {
+						id = strings.TrimSpace(s)
+					}
+					trimmed[i] = id
+				}
+				value = trimmed
+			}
+		}
+		normalized[key] = value
+	}
+	return mapstructure.Decode(normalized, params)
+}
+
+// projectErrorType* classify a failed GraphQL call so callers can tell, say, an unknown project_id from a missing permission.
+const (
+	projectErrorTypeNotFound           = "NOT_FOUND"
+	projectErrorTypeForbidden          = "FORBIDDEN"
+	projectErrorTypeInsufficientScopes = "INSUFFICIENT_SCOPES"
+	projectErrorTypeUnauthorized       = "UNAUTHORIZED"
+	projectErrorTypeRateLimited        = "RATE_LIMITED"
+	projectErrorTypeUnknown            = "UNKNOWN"
+)
+
+// projectErrorTypePatterns maps lowercase fragments of GitHub error messages to an error type, checked in order.
+// VERIFIED: shurcooL/graphql keeps only the message of each GraphQL error and drops its type and path,
+// so the type is recovered from the wording GitHub uses for each case
+var projectErrorTypePatterns = []struct {
+	fragment  string
+	errorType string
+}{
+	{"required scopes", projectErrorTypeInsufficientScopes},
+	{"rate limit", projectErrorTypeRateLimited},
+	{"could not resolve to", projectErrorTypeNotFound},
+	{"not found", projectErrorTypeNotFound},
+	{"resource not accessible", projectErrorTypeForbidden},
+	{"does not have permission", projectErrorTypeForbidden},
+	{"must have", projectErrorTypeForbidden},
+	{"status code: 403", projectErrorTypeForbidden},
+	{"bad credentials", projectErrorTypeUnauthorized},
+	{"status code: 401", projectErrorTypeUnauthorized},
+}
+
+// projectGraphQLErrorDetail is a single GraphQL error reported by GitHub.
+type projectGraphQLErrorDetail struct {
+	Message string `json:"message"`
+	Type    string `json:"type"`
+}
+
+// projectToolError is the structured content returned alongside the text of a failed project tool call.
+type projectToolError struct {
+	Error  string                      `json:"error"`
+	Type   string                      `json:"type"`
+	Errors []projectGraphQLErrorDetail `json:"errors"`
+}
+
+// classifyProjectError returns the error type GitHub's message corresponds to, or projectErrorTypeUnknown.
+func classifyProjectError(message string) string {
+	lower := strings.ToLower(message)
+	for _, pattern := range projectErrorTypePatterns {
+		if strings.Contains(lower, pattern.fragment) {
+			return pattern.errorType
+		}
+	}
+	return projectErrorTypeUnknown
+}
+
+// graphQLErrorDetails lists every GraphQL error wrapped in err.
+// UNDERSTANDING: the client's error value is a slice of {Message, Locations} whose Error() reports only the first message,
+// so the slice is read by reflection to keep the rest; any other error is reported as a single entry
+func graphQLErrorDetails(err error) []projectGraphQLErrorDetail {
+	for e := err; e != nil; e = errors.Unwrap(e) {
+		v := reflect.ValueOf(e)
+		if v.Kind() != reflect.Slice || v.Type().Elem().Kind() != reflect.Struct {
+			continue
+		}
+		field, ok := v.Type().Elem().FieldByName("Message")
+		if !ok || field.Type.Kind() != reflect.String || v.Len() == 0 {
+			continue
+		}
+		details := make([]projectGraphQLErrorDetail, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			message := v.Index(i).FieldByIndex(field.Index).String()
+			details = append(details, projectGraphQLErrorDetail{Message: message, Type: classifyProjectError(message)})
+		}
+		return details
+	}
+	return []projectGraphQLErrorDetail{{Message: err.Error(), Type: classifyProjectError(err.Error())}}
+}
+
+// projectGraphQLErrorResult reports a failed GraphQL call as a tool error.
+// UNDERSTANDING: the text keeps the "<message>: <error>" form, suffixed with the error type when it is known,
+// and the structured content carries every GraphQL error with its type; the error is also recorded for middleware
+func projectGraphQLErrorResult(ctx context.Context, message string, err error) *mcp.CallToolResult {
+	details := graphQLErrorDetails(err)
+	errorType := details[0].Type
+
+	result := ghErrors.NewGitHubGraphQLErrorResponse(ctx, message, err)
+	if errorType != projectErrorTypeUnknown {
+		result.Content = []mcp.Content{mcp.NewTextContent(fmt.Sprintf("%s: %v (%s)", message, err, errorType))}
+	}
+	result.StructuredContent = projectToolError{
+		Error:  fmt.Sprintf("%s: %v", message, err),
+		Type:   errorType,
+		Errors: details,
+	}
+	return result
+}
+
+// rawGraphQLRecorderKey is the context key of the recorder that collects GraphQL response bodies for the raw debug flag.
+type rawGraphQLRecorderKey struct{}
+
+// rawGraphQLRecorder collects the GraphQL response bodies of one tool call, in the order they arrived.
+type rawGraphQLRecorder struct {
+	mu        sync.Mutex
+	responses []interface{}
+}
+
+func (r *rawGraphQLRecorder) record(body []byte) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if json.Valid(body) {
+		r.responses = append(r.responses, json.RawMessage(body))
+		return
+	}
+	r.responses = append(r.responses, string(body))
+}
+
+// attach adds the recorded responses to result as raw_responses, inside the JSON object of a successful
+// result and as an extra text content otherwise, so the normalized output is left as it was.
+func (r *rawGraphQLRecorder) attach(result *mcp.CallToolResult) *mcp.CallToolResult {
+	r.mu.Lock()
+	responses := append([]interface{}{}, r.responses...)
+	r.mu.Unlock()
+
+	if !result.IsError && len(result.Content) > 0 {
+		if text, ok := result.Content[0].(mcp.TextContent); ok {
+			decoder := json.NewDecoder(strings.NewReader(text.Text))
+			decoder.UseNumber()
+			var response map[string]interface{}
+			if decoder.Decode(&response) == nil && response != nil {
+				response["raw_responses"] = responses
+				if merged, err := json.Marshal(response); err == nil {
+					result.Content[0] = mcp.NewTextContent(string(merged))
+					return result
+				}
+			}
+		}
+	}
+	encoded, err := json.Marshal(map[string]interface{}{"raw_responses": responses})
+	if err != nil {
+		return result
+	}
+	result.Content = append(result.Content, mcp.NewTextContent(string(encoded)))
+	return result
+}
+
+// RawGraphQLTransport copies GraphQL response bodies to the raw recorder of the request's context.
+// Requests made outside a project tool called with raw: true carry no recorder and pass through untouched.
+type RawGraphQLTransport struct {
+	Transport http.RoundTripper
+}
+
+func (t *RawGraphQLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Transport.RoundTrip(req)
+	recorder, ok := req.Context().Value(rawGraphQLRecorderKey{}).(*rawGraphQLRecorder)
+	if err != nil || !ok {
+		return resp, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	recorder.record(body)
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// Defaults for RetryGraphQLTransport when its fields are left zero.
+const (
+	defaultGraphQLRetryBaseDelay  = time.Second
+	defaultGraphQLRetryMaxBackoff = time.Minute
+)
+
+// RetryGraphQLTransport retries GraphQL requests that GitHub rejected for a primary or secondary rate limit.
+// UNDERSTANDING: bulk project operations run into secondary rate limits (403 or 429, often with Retry-After)
+// and primary ones (200 with a rate limit error and no data); both are retried here so every tool using
+// the GraphQL client benefits without wrapping each Query/Mutate call
+// VERIFIED: a rate-limited request was rejected before it ran, so resending it cannot apply a mutation twice
+type RetryGraphQLTransport struct {
+	Transport http.RoundTripper
+	// MaxRetries is how many times a rate-limited request is resent; zero disables retries.
+	MaxRetries int
+	// BaseDelay is the first backoff without Retry-After, doubled on each retry (default 1s).
+	BaseDelay time.Duration
+	// MaxBackoff caps any single wait, including one asked for by Retry-After (default 1m).
+	MaxBackoff time.Duration
+
+	sleep func(context.Context, time.Duration) error
+}
+
+func (t *RetryGraphQLTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	sleep := t.sleep
+	if sleep == nil {
+		sleep = sleepWithContext
+	}
+	delay := cmp.Or(t.BaseDelay, defaultGraphQLRetryBaseDelay)
+	maxBackoff := cmp.Or(t.MaxBackoff, defaultGraphQLRetryMaxBackoff)
+
+	for attempt := 0; ; attempt++ {
+		resp, err := t.Transport.RoundTrip(req)
+		if err != nil || attempt >= t.MaxRetries || req.Body != nil && req.GetBody == nil {
+			return resp, err
+		}
+		limited, err := rateLimitedGraphQLResponse(resp)
+		if err != nil || !limited {
+			return resp, err
+		}
+
+		wait := delay
+		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			wait = retryAfter
+		}
+		_ = resp.Body.Close()
+		if err := sleep(req.Context(), min(wait, maxBackoff)); err != nil {
+			return nil, err
+		}
+		delay *= 2
+
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.Clone(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+// rateLimitedGraphQLResponse reports whether resp is a rate limit or abuse detection rejection.
+// The body is read and replaced so the response can still be returned to the client.
+func rateLimitedGraphQLResponse(resp *http.Response) (bool, error) {
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true, nil
+	case http.StatusForbidden, http.StatusOK:
+	default:
+		return false, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return false, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	if resp.StatusCode == http.StatusForbidden {
+		lower := strings.ToLower(string(body))
+		return resp.Header.Get("Retry-After") != "" || strings.Contains(lower, "rate limit") || strings.Contains(lower, "abuse"), nil
+	}
+
+	// A 200 is only a rejection when nothing ran: no data and a rate limit error.
+	var payload struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+			Type    string `json:"type"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal(body, &payload) != nil || len(payload.Data) > 0 && string(payload.Data) != "null" {
+		return false, nil
+	}
+	for _, gqlErr := range payload.Errors {
+		if gqlErr.Type == projectErrorTypeRateLimited || mentionsRateLimit(gqlErr.Message) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseRetryAfter reads a Retry-After header given in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		return max(time.Until(at), 0), true
+	}
+	return 0, false
+}
+
+// withRawGraphQLResponses adds the raw debug flag to a project tool.
+// UNDERSTANDING: with raw: true the handler runs with a recorder in its context, and every GraphQL response
+// it received is returned as raw_responses next to the normalized output; off by default
+func withRawGraphQLResponses(tool mcp.Tool, handler server.ToolHandlerFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	properties := make(map[string]interface{}, len(tool.InputSchema.Properties)+1)
+	maps.Copy(properties, tool.InputSchema.Properties)
+	properties["raw"] = map[string]interface{}{
+		"type":        "boolean",
+		"description": "Debugging aid: also return the unprocessed GraphQL responses as raw_responses (default false)",
+	}
+	tool.InputSchema.Properties = properties
+
+	return tool, func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+		if raw, _ := request.GetArguments()["raw"].(bool); !raw {
+			return handler(ctx, request)
+		}
+		recorder := &rawGraphQLRecorder{}
+		result, err := handler(context.WithValue(ctx, rawGraphQLRecorderKey{}, recorder), request)
+		if err != nil || result == nil {
+			return result, err
+		}
+		return recorder.attach(result), nil
+	}
+}
+
+// validateProjectOwner checks that ownerID is the node ID of a user or organization.
+func validateProjectOwner(ctx context.Context, client *githubv4.Client, ownerID string) error {
+	var query struct {
+		Node struct {
+			Typename githubv4.String `graphql:"__typename"`
+		} `graphql:"node(id: $id)"`
+	}
+	if err := client.Query(ctx, &query, map[string]interface{}{
+		"id": githubv4.ID(ownerID),
+	}); err != nil {
+		return fmt.Errorf("failed to look up owner_id %s: %w", ownerID, err)
+	}
+
+	switch query.Node.Typename {
+	case "User", "Organization":
+		return nil
+	case "":
+		return fmt.Errorf("owner_id %s was not found", ownerID)
+	default:
+		return fmt.Errorf("owner_id %s is a %s, not a User or Organization; projects must be owned by a user or organization (use get_me to find your user ID)", ownerID, query.Node.Typename)
+	}
+}
+
+// ownerIDCacheTTL is how long a resolved login → owner ID mapping is reused before it is looked up again.
+const ownerIDCacheTTL = 10 * time.Minute
+
+// resolvedOwner is a user or organization resolved from its login.
+type resolvedOwner struct {
+	ID   string
+	Type string // "user" or "organization"
+}
+
+// ownerIDCache remembers resolved owners keyed by login and requested type. Failed lookups are not cached.
+type ownerIDCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]ownerIDCacheEntry
+}
+
+type ownerIDCacheEntry struct {
+	owner   resolvedOwner
+	expires time.Time
+}
+
+func newOwnerIDCache(ttl time.Duration) *ownerIDCache {
+	return &ownerIDCache{ttl: ttl, entries: map[string]ownerIDCacheEntry{}}
+}
+
+func (c *ownerIDCache) get(key string) (resolvedOwner, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		return resolvedOwner{}, false
+	}
+	return entry.owner, true
+}
+
+func (c *ownerIDCache) set(key string, owner resolvedOwner) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[key] = ownerIDCacheEntry{owner: owner, expires: time.Now().Add(c.ttl)}
+}
+
+// ownerIDs is shared by every tool so agents that repeat the same login only pay for the lookup once.
+var ownerIDs = newOwnerIDCache(ownerIDCacheTTL)
+
+// resolveOwnerID looks up the node ID of a user or organization login. ownerType is "user", "organization",
+// or empty to accept either; the result says which one the login turned out to be.
+// UNDERSTANDING: repositoryOwner(login:) resolves users and organizations alike, so one query covers both
+func resolveOwnerID(ctx context.Context, client *githubv4.Client, login, ownerType string) (resolvedOwner, error) {
+	ownerType = strings.ToLower(ownerType)
+	key := strings.ToLower(login) + "/" + ownerType
+	if owner, ok := ownerIDs.get(key); ok {
+		return owner, nil
+	}
+
+	var query struct {
+		RepositoryOwner *struct {
+			Typename githubv4.String `graphql:"__typename"`
+			ID       githubv4.ID
+		} `graphql:"repositoryOwner(login: $login)"`
+	}
+	if err := client.Query(ctx, &query, map[string]interface{}{
+		"login": githubv4.String(login),
+	}); err != nil {
+		return resolvedOwner{}, fmt.Errorf("failed to look up owner %s: %w", login, err)
+	}
+	if query.RepositoryOwner == nil {
+		return resolvedOwner{}, fmt.Errorf("could not resolve to a user or organization with the login %q", login)
+	}
+
+	owner := resolvedOwner{
+		ID:   fmt.Sprint(query.RepositoryOwner.ID),
+		Type: strings.ToLower(string(query.RepositoryOwner.Typename)),
+	}
+	if ownerType != "" && owner.Type != ownerType {
+		return resolvedOwner{}, fmt.Errorf("login %s belongs to a %s, but owner_type is %s", login, owner.Type, ownerType)
+	}
+	ownerIDs.set(key, owner)
+	return owner, nil
+}
+
+// projectFieldsCacheTTL is how long a project's field definitions are reused to validate name-based updates.
+const projectFieldsCacheTTL = time.Minute
+
+// projectFieldsCache remembers each project's fields so field and option names can be checked without a query.
+type projectFieldsCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]projectFieldsCacheEntry
+}
+
+type projectFieldsCacheEntry struct {
+	fields  []projectField
+	expires time.Time
+}
+
+func newProjectFieldsCache(ttl time.Duration) *projectFieldsCache {
+	return &projectFieldsCache{ttl: ttl, entries: map[string]projectFieldsCacheEntry{}}
+}
+
+func (c *projectFieldsCache) get(projectID string) ([]projectField, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry, ok := c.entries[projectID]
+	if !ok || time.Now().After(entry.expires) {
+		delete(c.entries, projectID)
+		return nil, false
+	}
+	return entry.fields, true
+}
+
+func (c *projectFieldsCache) set(projectID string, fields []projectField) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[projectID] = projectFieldsCacheEntry{fields: fields, expires: time.Now().Add(c.ttl)}
+}
+
+func (c *projectFieldsCache) invalidate(projectID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, projectID)
+}
+
+// reset drops every project's fields, for updates that only know a field ID and not its project.
+func (c *projectFieldsCache) reset() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = map[string]projectFieldsCacheEntry{}
+}
+
+// projectFieldDefinitions is shared by every tool that validates field and option names before writing.
+var projectFieldDefinitions = newProjectFieldsCache(projectFieldsCacheTTL)
+
+// fetchCachedProjectFields returns the project's fields from projectFieldDefinitions, fetching them on a miss or
+// when refresh is set. The second result reports whether the fields came from the cache.
+func fetchCachedProjectFields(ctx context.Context, client *githubv4.Client, projectID string, refresh bool) ([]projectField, bool, error) {
+	if !refresh {
+		if fields, ok := projectFieldDefinitions.get(projectID); ok {
+			return fields, true, nil
+		}
+	}
+	fields, err := fetchProjectFields(ctx, client, projectID)
+	if err != nil {
+		return nil, false, err
+	}
+	projectFieldDefinitions.set(projectID, fields)
+	return fields, false, nil
+}
+
+// addItemToProjectMutation adds an item to a project and selects where it landed, so the result can be linked.
+type addItemToProjectMutation struct {
+	AddProjectV2ItemById struct {
+		Item addedProjectItem
+	} `graphql:"addProjectV2ItemById(input: $input)"`
+}
+
+// addedProjectItem is an item just added to a project, with its project and the URL of its issue or pull request.
+type addedProjectItem struct {
+	ID         githubv4.ID
+	DatabaseID githubv4.Int
+	Project    struct {
+		Number githubv4.Int
+		URL    githubv4.String
+	}
+	Content *struct {
+		Issue struct {
+			URL githubv4.String
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			URL githubv4.String
+		} `graphql:"... on PullRequest"`
+	}
+}
+
+// url returns the URL of the item's issue or pull request, or "" when the content is not visible.
+func (i addedProjectItem) url() githubv4.String {
+	switch {
+	case i.Content == nil:
+		return ""
+	case i.Content.Issue.URL != "":
+		return i.Content.Issue.URL
+	default:
+		return i.Content.PullRequest.URL
+	}
+}
+
+// UNDERSTANDING: Core function to add an issue/PR to a GitHub Projects v2 board
+// EXPECTS: issue_url (full GitHub URL or content node ID), project_id (from GitHub Projects v2 API), optional initial status
+// RETURNS: Success confirmation with item details, the project number and URL, and the item's issue/PR URL
+// INTEGRATION: Uses GraphQL mutation addProjectV2ItemById following existing MCP patterns
+func AddItemToProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("add_item_to_project",
+			mcp.WithDescription(t("TOOL_ADD_ITEM_TO_PROJECT_DESCRIPTION", "Add an issue or pull request to a GitHub Projects v2 board. This bridges the gap between issue creation and project management by automatically adding items to project boards.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_ITEM_TO_PROJECT_USER_TITLE", "Add item to project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID (use list_user_projects to find this)"),
+			),
+			mcp.WithString("issue_url",
+				mcp.Required(),
+				mcp.Description("Full GitHub URL of the issue or pull request (e.g., 'https://github.com/owner/repo/issues/123'), or its node ID (I_xxxx or PR_xxxx)"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Optional single-select field to set after adding (default: 'Status' when a status option is given)"),
+			),
+			mcp.WithString("status_field_id",
+				mcp.Description("Optional single-select field ID to set after adding, instead of status_field_name"),
+			),
+			mcp.WithString("status_option_name",
+				mcp.Description("Optional option name to set on the new item (e.g., 'Todo'), matched ignoring case and spacing"),
+			),
+			mcp.WithString("status_option_id",
+				mcp.Description("Optional option ID to set on the new item, instead of status_option_name"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				IssueURL  string `mapstructure:"issue_url"`
+
+				StatusFieldName  string `mapstructure:"status_field_name"`
+				StatusFieldID    string `mapstructure:"status_field_id"`
+				StatusOptionName string `mapstructure:"status_option_name"`
+				StatusOptionID   string `mapstructure:"status_option_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// UNDERSTANDING: Get GraphQL client following existing patterns
+			// VERIFIED: Same pattern used in pullrequests.go:1155-1158
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// UNDERSTANDING: Resolve the initial status before adding, so a bad field or option adds nothing
+			var status *coercedFieldValue
+			var statusField projectField
+			if params.StatusOptionID != "" || params.StatusOptionName != "" {
+				statusField, err = resolveProjectStatusField(ctx, client, params.ProjectID, params.StatusFieldID, params.StatusFieldName)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+
+				raw := params.StatusOptionID
+				if raw == "" {
+					raw = params.StatusOptionName
+				}
+				coerced, err := coerceProjectFieldValue(statusField, raw)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				status = &coerced
+			}
+
+			// UNDERSTANDING: addProjectV2ItemById only takes a content node ID, so URLs are resolved first
+			contentID := strings.TrimSpace(params.IssueURL)
+			if strings.Contains(contentID, "/") {
+				content, err := resolveProjectContent(ctx, client, contentID)
+				if err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to resolve issue_url", err), nil
+				}
+				contentID = content.ContentID
+			}
+
+			// UNDERSTANDING: Execute addProjectV2ItemById mutation
+			// EXPECTS: GitHub Projects v2 API requires project node ID and content ID
+			// RETURNS: Item details including database ID for future operations
+			// INTEGRATION: Direct GraphQL mutation following GitHub's Projects v2 schema
+			var addItemMutation addItemToProjectMutation
+
+			if err := client.Mutate(
+				ctx,
+				&addItemMutation,
+				githubv4.AddProjectV2ItemByIdInput{
+					ProjectID: githubv4.ID(params.ProjectID),
+					ContentID: githubv4.ID(contentID),
+				},
+				nil,
+			); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to add item to project", err), nil
+			}
+
+			// UNDERSTANDING: Return success response with item details
+			// INTEGRATION: Consistent with other MCP tool success responses
+			item := addItemMutation.AddProjectV2ItemById.Item
+			itemID := item.ID
+			response := map[string]interface{}{
+				"success":        true,
+				"message":        "Item successfully added to project",
+				"item_id":        itemID,
+				"database_id":    int(item.DatabaseID),
+				"content_id":     contentID,
+				"project_number": int(item.Project.Number),
+				"project_url":    item.Project.URL,
+				"item_url":       item.url(),
+			}
+
+			if status != nil {
+				var updateFieldMutation struct {
+					UpdateProjectV2ItemFieldValue struct {
+						ProjectV2Item struct {
+							ID githubv4.ID
+						}
+					} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+					ProjectID: githubv4.ID(params.ProjectID),
+					ItemID:    itemID,
+					FieldID:   githubv4.ID(statusField.ID),
+					Value:     status.Input,
+				}, nil); err != nil {
+					// VERIFIED: The item is already on the board at this point, so report its ID with the failure
+					return mcp.NewToolResultError(fmt.Sprintf("item %v was added to the project but setting %s failed: %v", itemID, statusField.Name, err)), nil
+				}
+				response["message"] = fmt.Sprintf("Item successfully added to project with %s %s", statusField.Name, status.Value)
+				response["status"] = map[string]interface{}{
+					"field_id":    statusField.ID,
+					"field_name":  statusField.Name,
+					"option_id":   status.OptionID,
+					"option_name": status.Value,
+				}
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: List a user's or organization's Projects v2 boards
+// EXPECTS: login, optional owner_type (user|organization), first and after (end_cursor of the previous page), optional
+// sort (updated|created|title) and direction
+// RETURNS: The same shape for users and organizations: login, owner_type, projects and paging info
+// INTEGRATION: Without owner_type the login's type is looked up (and cached) with resolveOwnerID
+func ListUserProjects(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_user_projects",
+			mcp.WithDescription(t("TOOL_LIST_USER_PROJECTS_DESCRIPTION", "List GitHub Projects v2 boards for a user or organization. Use this to find project IDs needed for adding items to projects.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_USER_PROJECTS_USER_TITLE", "List user projects"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("login",
+				mcp.Required(),
+				mcp.Description("GitHub username or organization name"),
+			),
+			mcp.WithString("owner_type",
+				mcp.Description("Whether login is a user or an organization. Detected automatically when omitted"),
+				mcp.Enum("user", "organization"),
+			),
+			mcp.WithNumber("first",
+				mcp.Description("Number of projects to retrieve (default: 10, max: 100)"),
+			),
+			mcp.WithString("after",
+				mcp.Description("Cursor for the next page: pass the end_cursor of the previous response while has_next_page is true"),
+			),
+			mcp.WithString("sort",
+				mcp.Description("Sort the returned projects by last update, creation time or title. Sorting applies to the returned page, so use first: 100 to rank all of an owner's boards"),
+				mcp.Enum("updated", "created", "title"),
+			),
+			mcp.WithString("direction",
+				mcp.Description("Sort direction (default: 'desc' for updated and created, 'asc' for title)"),
+				mcp.Enum("asc", "desc"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				Login     string `mapstructure:"login"`
+				OwnerType string `mapstructure:"owner_type"`
+				First     *int   `mapstructure:"first"`
+				After     string `mapstructure:"after"`
+				Sort      string `mapstructure:"sort"`
+				Direction string `mapstructure:"direction"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			switch params.Sort {
+			case "", "updated", "created", "title":
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid sort %q: must be one of updated, created, title", params.Sort)), nil
+			}
+			if params.Direction != "" && params.Direction != "asc" && params.Direction != "desc" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid direction %q: must be asc or desc", params.Direction)), nil
+			}
+
+			// UNDERSTANDING: Default pagination following GitHub API best practices
+			// VERIFIED: Consistent with existing pagination in discussions.go:16
+			if params.First == nil {
+				defaultFirst := 10
+				params.First = &defaultFirst
+			}
+			if *params.First > 100 {
+				*params.First = 100
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// VERIFIED: user(login:) does not resolve organizations, so without owner_type the login's type is
+			// resolved first; the lookup is cached, so listing the same owner again costs a single query
+			ownerType := strings.ToLower(params.OwnerType)
+			if ownerType == "" {
+				owner, err := resolveOwnerID(ctx, client, params.Login, "")
+				if err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to query user projects", err), nil
+				}
+				ownerType = owner.Type
+			}
+			var after *githubv4.String
+			if params.After != "" {
+				after = githubv4.NewString(githubv4.String(params.After))
+			}
+			projects, err := fetchOwnerProjects(ctx, client, params.Login, ownerType, *params.First, after)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to query user projects", err), nil
+			}
+			if params.Sort != "" {
+				projects.sortNodes(params.Sort, params.Direction)
+			}
+
+			response := map[string]interface{}{
+				"login":         params.Login,
+				"owner_type":    ownerType,
+				"projects":      projects.Nodes,
+				"total_count":   int(projects.TotalCount),
+				"has_next_page": bool(projects.PageInfo.HasNextPage),
+				"end_cursor":    projects.PageInfo.EndCursor,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Update project item field values (move between columns, update status)
+// EXPECTS: project_id, item_id, field_id, value (string/single_select/date/number), optional dry_run
+// RETURNS: Success confirmation with updated field details
+// INTEGRATION: Enables workflow automation by updating project board item states
+func UpdateProjectItemStatus(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_item_status",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_ITEM_STATUS_DESCRIPTION", "Update a project item's field value (status, assignee, priority, etc.) in GitHub Projects v2. Use this to move items between columns or update custom fields.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PROJECT_ITEM_STATUS_USER_TITLE", "Update project item status"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (returned from add_item_to_project)"),
+			),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Project field ID to update (use get_project_fields to find this)"),
+			),
+			mcp.WithString("value",
+				mcp.Required(),
+				mcp.Description("New field value, interpreted by field type: text, number, date (YYYY-MM-DD), single-select option ID or name, or iteration ID, title, current or next"),
+			),
+			mcp.WithString("operation",
+				mcp.Description("For number fields: 'set' (default) replaces the value, 'increment' or 'decrement' adjusts the current value by value"),
+				mcp.Enum("set", "increment", "decrement"),
+			),
+			mcp.WithString("mode",
+				mcp.Description("For text fields: 'set' (default) replaces the value, 'append' or 'prepend' adds value to the end or start of the current text"),
+				mcp.Enum("set", "append", "prepend"),
+			),
+			mcp.WithString("separator",
+				mcp.Description("Text placed between the current value and value when appending or prepending (default: newline)"),
+			),
+			mcp.WithBoolean("dry_run",
+				mcp.Description("Report how the value would be interpreted for the field without updating the item"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string  `mapstructure:"project_id"`
+				ItemID    string  `mapstructure:"item_id"`
+				FieldID   string  `mapstructure:"field_id"`
+				Value     string  `mapstructure:"value"`
+				Operation string  `mapstructure:"operation"`
+				Mode      string  `mapstructure:"mode"`
+				Separator *string `mapstructure:"separator"`
+				DryRun    bool    `mapstructure:"dry_run"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.Operation == "" {
+				params.Operation = "set"
+			}
+			if params.Operation != "set" && params.Operation != "increment" && params.Operation != "decrement" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid operation %q: must be one of set, increment, decrement", params.Operation)), nil
+			}
+			if params.Mode == "" {
+				params.Mode = "set"
+			}
+			if params.Mode != "set" && params.Mode != "append" && params.Mode != "prepend" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid mode %q: must be one of set, append, prepend", params.Mode)), nil
+			}
+			if params.Operation != "set" && params.Mode != "set" {
+				return mcp.NewToolResultError("operation and mode cannot both be used: operation applies to NUMBER fields and mode to TEXT fields"), nil
+			}
+			separator := "\n"
+			if params.Separator != nil {
+				separator = *params.Separator
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// UNDERSTANDING: The field type decides how the raw value is sent, so look the field up first
+			field, err := fetchProjectField(ctx, client, params.FieldID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project field", err), nil
+			}
+			coerced, err := coerceProjectFieldValue(field, params.Value)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// UNDERSTANDING: Relative updates read the item's current number; an unset value counts as 0
+			var previous interface{}
+			if params.Operation != "set" {
+				if field.DataType != "NUMBER" {
+					return mcp.NewToolResultError(fmt.Sprintf("operation %q is only supported for NUMBER fields, but field %q is a %s field", params.Operation, field.Name, field.DataType)), nil
+				}
+				item, err := fetchProjectItem(ctx, client, params.ItemID)
+				if err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+				}
+				current := 0.0
+				if value, ok := item.fieldValueByID(field.ID); ok {
+					current, _ = value.Value.(float64)
+				}
+				delta := coerced.Value.(float64)
+				if params.Operation == "decrement" {
+					delta = -delta
+				}
+				previous = current
+				if coerced, err = coerceProjectFieldValue(field, strconv.FormatFloat(current+delta, 'f', -1, 64)); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				coerced.Report = fmt.Sprintf("%s '%s' → number %v → %v for field %s", params.Operation, params.Value, current, coerced.Value, field.Name)
+			}
+
+			// UNDERSTANDING: Append and prepend keep running notes instead of overwriting them; an unset value is just replaced
+			if params.Mode != "set" {
+				if field.DataType != "TEXT" {
+					return mcp.NewToolResultError(fmt.Sprintf("mode %q is only supported for TEXT fields, but field %q is a %s field", params.Mode, field.Name, field.DataType)), nil
+				}
+				item, err := fetchProjectItem(ctx, client, params.ItemID)
+				if err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+				}
+				current := ""
+				if value, ok := item.fieldValueByID(field.ID); ok {
+					current, _ = value.Value.(string)
+				}
+				combined := params.Value
+				switch {
+				case current == "":
+				case params.Mode == "append":
+					combined = current + separator + params.Value
+				default:
+					combined = params.Value + separator + current
+				}
+				previous = current
+				if coerced, err = coerceProjectFieldValue(field, combined); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				coerced.Report = fmt.Sprintf("%s '%s' to the current text of field %s", params.Mode, params.Value, field.Name)
+			}
+
+			if params.DryRun {
+				response := map[string]interface{}{
+					"dry_run":       true,
+					"item_id":       params.ItemID,
+					"field_id":      field.ID,
+					"field_name":    field.Name,
+					"field_type":    field.DataType,
+					"raw_value":     params.Value,
+					"coerced_value": coerced.Value,
+					"coercion":      coerced.Report,
+				}
+				if coerced.OptionID != "" {
+					response["option_id"] = coerced.OptionID
+				}
+				if coerced.IterationID != "" {
+					response["iteration_id"] = coerced.IterationID
+				}
+				if previous != nil {
+					addRelativeUpdate(response, params.Operation, params.Mode, previous)
+				}
+
+				responseJSON, err := json.Marshal(response)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+				}
+
+				return mcp.NewToolResultText(string(responseJSON)), nil
+			}
+
+			// UNDERSTANDING: Update project item field using GitHub's updateProjectV2ItemFieldValue mutation
+			// EXPECTS: Project ID, item ID, field ID, and properly formatted value
+			// RETURNS: Updated field details including the new value
+			// INTEGRATION: Core workflow automation - moves items between columns and updates statuses
+			var updateFieldMutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+
+			if err := client.Mutate(
+				ctx,
+				&updateFieldMutation,
+				githubv4.UpdateProjectV2ItemFieldValueInput{
+					ProjectID: githubv4.ID(params.ProjectID),
+					ItemID:    githubv4.ID(params.ItemID),
+					FieldID:   githubv4.ID(params.FieldID),
+					Value:     coerced.Input,
+				},
+				nil,
+			); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to update project item field", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success": true,
+				"message": "Project item field updated successfully",
+				"item_id": updateFieldMutation.UpdateProjectV2ItemFieldValue.ProjectV2Item.ID,
+				"field":   field.Name,
+				"value":   coerced.Value,
+			}
+			if coerced.OptionID != "" {
+				response["option_id"] = coerced.OptionID
+			}
+			if coerced.IterationID != "" {
+				response["iteration_id"] = coerced.IterationID
+			}
+			if previous != nil {
+				addRelativeUpdate(response, params.Operation, params.Mode, previous)
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// addRelativeUpdate reports how an update built on the item's current value on a tool response.
+func addRelativeUpdate(response map[string]interface{}, operation, mode string, previous interface{}) {
+	if mode != "set" {
+		response["mode"] = mode
+	} else {
+		response["operation"] = operation
+	}
+	response["previous_value"] = previous
+}
+
+// UNDERSTANDING: Link an existing GitHub Projects v2 board to a repository
+// EXPECTS: project_id (Projects v2 ID), repository_id (repository node ID)
+// RETURNS: Success confirmation of the linking operation
+// INTEGRATION: Solves the "put it back" use case - reconnect existing projects to repositories
+func LinkProjectToRepository(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("link_project_to_repository",
+			mcp.WithDescription(t("TOOL_LINK_PROJECT_TO_REPOSITORY_DESCRIPTION", "Link an existing GitHub Projects v2 board to a repository. Use this to reconnect projects that were previously unlinked or to establish new project-repository associations.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LINK_PROJECT_TO_REPOSITORY_USER_TITLE", "Link project to repository"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID (PVT_xxxx format)"),
+			),
+			mcp.WithString("repository_id",
+				mcp.Required(),
+				mcp.Description("GitHub repository node ID (R_xxxx format) - use get_repository to find this"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID    string `mapstructure:"project_id"`
+				RepositoryID string `mapstructure:"repository_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			warnings, err := checkProjectRepositoryIDs(params.ProjectID, params.RepositoryID)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// UNDERSTANDING: Get GraphQL client following existing patterns
+			// VERIFIED: Same pattern used in other Projects v2 tools
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// UNDERSTANDING: Execute linkProjectV2ToRepository mutation
+			// EXPECTS: GitHub Projects v2 project ID and repository node ID
+			// RETURNS: Success confirmation of the linking operation
+			// INTEGRATION: Direct GraphQL mutation following GitHub's Projects v2 schema
+			// VERIFIED: Payload only returns repository field, not project field
+			var linkProjectMutation struct {
+				LinkProjectV2ToRepository struct {
+					Repository struct {
+						ID   githubv4.ID
+						Name githubv4.String
+					}
+				} `graphql:"linkProjectV2ToRepository(input: $input)"`
+			}
+
+			if err := client.Mutate(
+				ctx,
+				&linkProjectMutation,
+				githubv4.LinkProjectV2ToRepositoryInput{
+					ProjectID:    githubv4.ID(params.ProjectID),
+					RepositoryID: githubv4.ID(params.RepositoryID),
+				},
+				nil,
+			); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to link project to repository", err), nil
+			}
+
+			// UNDERSTANDING: Return success response with linking details
+			// INTEGRATION: Consistent with other MCP tool success responses
+			// VERIFIED: Only repository details available in mutation payload
+			response := map[string]interface{}{
+				"success":         true,
+				"message":         "Project successfully linked to repository",
+				"project_id":      params.ProjectID, // Use input parameter since not returned
+				"repository_id":   linkProjectMutation.LinkProjectV2ToRepository.Repository.ID,
+				"repository_name": linkProjectMutation.LinkProjectV2ToRepository.Repository.Name,
+			}
+			if len(warnings) > 0 {
+				response["warnings"] = warnings
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// nodeIDKinds names the kind of object behind GitHub node ID prefixes.
+var nodeIDKinds = map[string]string{
+	"PVT_":    "Projects v2 project",
+	"PN_":     "Projects v2 project",
+	"PVTI_":   "project item",
+	"PVTF_":   "project field",
+	"PVTSSF_": "project field",
+	"PVTIF_":  "project field",
+	"PRO_":    "classic project",
+	"R_":      "repository",
+	"I_":      "issue",
+	"PR_":     "pull request",
+	"D_":      "discussion",
+	"DI_":     "draft issue",
+	"U_":      "user",
+	"O_":      "organization",
+}
+
+// nodeIDKind returns the kind of object a node ID belongs to, or "" when its prefix is not known.
+func nodeIDKind(id string) string {
+	i := strings.Index(id, "_")
+	if i < 0 {
+		return ""
+	}
+	return nodeIDKinds[id[:i+1]]
+}
+
+// checkNodeIDPrefix checks that id, passed as argument, is the node ID of a kind of object such as "repository".
+// UNDERSTANDING: An ID known to belong to another kind of object is an error naming the argument; an unknown prefix
+// only yields a warning, since older IDs have no prefix and GitHub may introduce new ones
+func checkNodeIDPrefix(argument, id, kind, prefix string) (warning string, err error) {
+	switch actual := nodeIDKind(id); actual {
+	case kind:
+		return "", nil
+	case "":
+		return fmt.Sprintf("%s %s does not start with %s as a %s ID usually does; passing it to GitHub as is", argument, id, prefix, kind), nil
+	default:
+		return "", fmt.Errorf("%s %s is a %s ID, not a %s ID (%sxxxx format)", argument, id, actual, kind, prefix)
+	}
+}
+
+// checkProjectRepositoryIDs checks the project_id and repository_id of a link or unlink, reporting swapped arguments as such.
+func checkProjectRepositoryIDs(projectID, repositoryID string) ([]string, error) {
+	if nodeIDKind(projectID) == "repository" && nodeIDKind(repositoryID) == "Projects v2 project" {
+		return nil, fmt.Errorf("project_id %s is a repository ID and repository_id %s is a project ID; the two arguments appear to be swapped", projectID, repositoryID)
+	}
+	var warnings []string
+	for _, check := range []struct{ argument, id, kind, prefix string }{
+		{"project_id", projectID, "Projects v2 project", "PVT_"},
+		{"repository_id", repositoryID, "repository", "R_"},
+	} {
+		warning, err := checkNodeIDPrefix(check.argument, check.id, check.kind, check.prefix)
+		if err != nil {
+			return nil, err
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+	return warnings, nil
+}
+
+// parseRepositoryURL splits a GitHub repository URL into owner and repo.
+// UNDERSTANDING: Any deeper path (e.g., /issues) and a trailing .git are ignored
+func parseRepositoryURL(rawURL string) (owner, repo string, err error) {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", "", fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if parsed.Host == "" || len(segments) < 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", fmt.Errorf("invalid URL %q: expected https://github.com/owner/repo", rawURL)
+	}
+	return segments[0], strings.TrimSuffix(segments[1], ".git"), nil
+}
+
+// resolveRepositoryID looks up the node ID of the repository at a GitHub URL.
+func resolveRepositoryID(ctx context.Context, client *githubv4.Client, rawURL string) (string, error) {
+	owner, repo, err := parseRepositoryURL(rawURL)
+	if err != nil {
+		return "", err
+	}
+
+	var query struct {
+		Repository struct {
+			ID githubv4.ID
+		} `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	if err := client.Query(ctx, &query, map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+	}); err != nil {
+		return "", err
+	}
+	if query.Repository.ID == nil {
+		return "", fmt.Errorf("repository %s/%s not found", owner, repo)
+	}
+	return fmt.Sprint(query.Repository.ID), nil
+}
+
+// UNDERSTANDING: Unlink a GitHub Projects v2 board from a repository
+// EXPECTS: project_id (Projects v2 ID), repository_id (repository node ID) or repository_url
+// RETURNS: Success confirmation of the unlinking operation
+// INTEGRATION: Inverse operation to LinkProjectToRepository for complete project-repository management
+func UnlinkProjectFromRepository(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("unlink_project_from_repository",
+			mcp.WithDescription(t("TOOL_UNLINK_PROJECT_FROM_REPOSITORY_DESCRIPTION", "Unlink a GitHub Projects v2 board from a repository. The project and its data remain intact, but it will no longer appear in the repository's Projects tab.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UNLINK_PROJECT_FROM_REPOSITORY_USER_TITLE", "Unlink project from repository"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID (PVT_xxxx format)"),
+			),
+			mcp.WithString("repository_id",
+				mcp.Description("GitHub repository node ID (R_xxxx format); required unless repository_url is given"),
+			),
+			mcp.WithString("repository_url",
+				mcp.Description("Repository URL (e.g., 'https://github.com/owner/repo') to resolve to its node ID, instead of repository_id"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID     string `mapstructure:"project_id"`
+				RepositoryID  string `mapstructure:"repository_id"`
+				RepositoryURL string `mapstructure:"repository_url"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if (params.RepositoryID == "") == (params.RepositoryURL == "") {
+				return mcp.NewToolResultError("exactly one of repository_id or repository_url must be provided"), nil
+			}
+
+			// UNDERSTANDING: Get GraphQL client following existing patterns
+			// VERIFIED: Same pattern used in other Projects v2 tools
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			if params.RepositoryURL != "" {
+				if params.RepositoryID, err = resolveRepositoryID(ctx, client, params.RepositoryURL); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to resolve repository", err), nil
+				}
+			}
+			warnings, err := checkProjectRepositoryIDs(params.ProjectID, params.RepositoryID)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// UNDERSTANDING: Execute unlinkProjectV2FromRepository mutation
+			// EXPECTS: GitHub Projects v2 project ID and repository node ID
+			// RETURNS: Success confirmation of the unlinking operation
+			// INTEGRATION: Direct GraphQL mutation following GitHub's Projects v2 schema
+			// VERIFIED: Payload only returns repository field, not project field
+			var unlinkProjectMutation struct {
+				UnlinkProjectV2FromRepository struct {
+					Repository struct {
+						ID   githubv4.ID
+						Name githubv4.String
+					}
+				} `graphql:"unlinkProjectV2FromRepository(input: $input)"`
+			}
+
+			if err := client.Mutate(
+				ctx,
+				&unlinkProjectMutation,
+				githubv4.UnlinkProjectV2FromRepositoryInput{
+					ProjectID:    githubv4.ID(params.ProjectID),
+					RepositoryID: githubv4.ID(params.RepositoryID),
+				},
+				nil,
+			); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to unlink project from repository", err), nil
+			}
+
+			// UNDERSTANDING: Return success response with unlinking details
+			// INTEGRATION: Consistent with other MCP tool success responses
+			// VERIFIED: Only repository details available in mutation payload
+			response := map[string]interface{}{
+				"success":         true,
+				"message":         "Project successfully unlinked from repository",
+				"project_id":      params.ProjectID, // Use input parameter since not returned
+				"repository_id":   unlinkProjectMutation.UnlinkProjectV2FromRepository.Repository.ID,
+				"repository_name": unlinkProjectMutation.UnlinkProjectV2FromRepository.Repository.Name,
+			}
+			if len(warnings) > 0 {
+				response["warnings"] = warnings
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Instantiate a new GitHub Projects v2 board from a template project
+// EXPECTS: template_project_id (source project node ID), owner_id (user/org node ID), title
+// RETURNS: The newly created project details, plus a warning when the source is not a template
+// INTEGRATION: Uses copyProjectV2 so fields, views and workflows carry over from the template
+func CreateProjectFromTemplate(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("create_project_from_template",
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_FROM_TEMPLATE_DESCRIPTION", "Create a new GitHub Projects v2 board from a template project. The new project inherits the template's fields, views and workflows. If the source project is not marked as a template it is still copied, and a warning is returned.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PROJECT_FROM_TEMPLATE_USER_TITLE", "Create project from template"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("template_project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 node ID of the template project to instantiate (PVT_xxxx format)"),
+			),
+			mcp.WithString("owner_id",
+				mcp.Required(),
+				mcp.Description("GitHub node ID of the user or organization who will own the new project (use get_me to find your user ID)"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title/name for the new project"),
+			),
+			mcp.WithBoolean("include_draft_issues",
+				mcp.Description("Also copy the template's draft issues into the new project (default: false)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				TemplateProjectID  string `mapstructure:"template_project_id"`
+				OwnerID            string `mapstructure:"owner_id"`
+				Title              string `mapstructure:"title"`
+				IncludeDraftIssues bool   `mapstructure:"include_draft_issues"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// UNDERSTANDING: Look up the source project first so we can tell the caller whether it is really a template
+			// VERIFIED: ProjectV2.template is true only for projects explicitly marked as templates
+			var templateQuery struct {
+				Node struct {
+					ProjectV2 struct {
+						ID       githubv4.ID
+						Title    githubv4.String
+						Template githubv4.Boolean
+					} `graphql:"... on ProjectV2"`
+				} `graphql:"node(id: $id)"`
+			}
+			if err := client.Query(ctx, &templateQuery, map[string]interface{}{
+				"id": githubv4.ID(params.TemplateProjectID),
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get template project", err), nil
+			}
+
+			source := templateQuery.Node.ProjectV2
+			if source.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("template project %s not found or is not a Projects v2 board", params.TemplateProjectID)), nil
+			}
+
+			// UNDERSTANDING: copyProjectV2 is the only API path for template instantiation
+			// RETURNS: The new project, which starts with the template's fields, views and workflows
+			var copyProjectMutation struct {
+				CopyProjectV2 struct {
+					ProjectV2 struct {
+						ID     githubv4.ID
+						Number githubv4.Int
+						Title  githubv4.String
+						URL    githubv4.String
+					}
+				} `graphql:"copyProjectV2(input: $input)"`
+			}
+
+			input := githubv4.CopyProjectV2Input{
+				ProjectID: githubv4.ID(params.TemplateProjectID),
+				OwnerID:   githubv4.ID(params.OwnerID),
+				Title:     githubv4.String(params.Title),
+			}
+			if params.IncludeDraftIssues {
+				input.IncludeDraftIssues = githubv4.NewBoolean(true)
+			}
+
+			if err := client.Mutate(ctx, &copyProjectMutation, input, nil); err != nil {
+				// VERIFIED: Older GitHub Enterprise Server releases predate copyProjectV2 and reject it as an unknown field
+				if isUnavailableMutation(err, "copyProjectV2") {
+					return mcp.NewToolResultError("this GitHub server does not support copying projects (copyProjectV2 is not available, as on older GitHub Enterprise Server releases). " +
+						"Recreate the project manually, or run export_project on the template and import_project to recreate its fields and draft issues; views and workflows are not carried over that way"), nil
+				}
+				return projectGraphQLErrorResult(ctx, "failed to create project from template", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":             true,
+				"message":             "Project created from template successfully",
+				"project_id":          copyProjectMutation.CopyProjectV2.ProjectV2.ID,
+				"project_number":      int(copyProjectMutation.CopyProjectV2.ProjectV2.Number),
+				"title":               copyProjectMutation.CopyProjectV2.ProjectV2.Title,
+				"url":                 copyProjectMutation.CopyProjectV2.ProjectV2.URL,
+				"template_project_id": params.TemplateProjectID,
+			}
+
+			// UNDERSTANDING: Copying a non-template still works, but the caller most likely picked the wrong source
+			if !source.Template {
+				response["warning"] = fmt.Sprintf("source project %q is not marked as a template; it was copied as a regular project", source.Title)
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// unsupportedProjectContentFragments are lowercase fragments of GitHub's error for content a project cannot hold.
+// VERIFIED: addProjectV2ItemById rejects content that is not an Issue or PullRequest with one of these wordings
+var unsupportedProjectContentFragments = []string{
+	"must be an issue or pull",
+	"content type",
+}
+
+// isUnsupportedProjectContent reports whether err is GitHub refusing an item because of its content type.
+func isUnsupportedProjectContent(err error) bool {
+	for _, detail := range graphQLErrorDetails(err) {
+		lower := strings.ToLower(detail.Message)
+		for _, fragment := range unsupportedProjectContentFragments {
+			if strings.Contains(lower, fragment) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isUnavailableMutation reports whether err is the GraphQL schema error for a mutation the server does not have.
+func isUnavailableMutation(err error, mutation string) bool {
+	return err != nil && strings.Contains(err.Error(), fmt.Sprintf("Field '%s' doesn't exist on type 'Mutation'", mutation))
+}
+
+// UNDERSTANDING: Board-walking query shared by the tools that need to look at every item on a project
+// EXPECTS: projectId (ProjectV2 node ID), first (page size), after (cursor, nil for the first page)
+// RETURNS: One page of items with their content and field values, in manual board order
+// INTEGRATION: Normalized into projectItem by fetchAllProjectItems so each tool works on plain Go values
+type projectItemsQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Items struct {
+				Nodes    []projectItemNode
+				PageInfo struct {
+					HasNextPage githubv4.Boolean
+					EndCursor   githubv4.String
+				}
+				TotalCount githubv4.Int
+			} `graphql:"items(first: $first, after: $after, orderBy: {field: POSITION, direction: ASC})"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+// projectItemNode is a single ProjectV2Item as selected by projectItemsQuery.
+// VERIFIED: Issue.state and PullRequest.state are different enums, so they must be aliased to avoid a field conflict
+type projectItemNode struct {
+	ID        githubv4.ID
+	Type      githubv4.String
+	CreatedAt githubv4.DateTime
+	UpdatedAt githubv4.DateTime
+	// Content is nil when the underlying issue or pull request was deleted
+	Content     *projectItemContent
+	FieldValues projectItemFieldValues `graphql:"fieldValues(first: 100)"`
+}
+
+// projectItemFieldValues is one page of a project item's field values.
+type projectItemFieldValues struct {
+	Nodes    []projectItemFieldValueNode
+	PageInfo struct {
+		HasNextPage githubv4.Boolean
+		EndCursor   githubv4.String
+	}
+}
+
+// projectItemFieldValuesQuery fetches the field values of a single item after a cursor.
+type projectItemFieldValuesQuery struct {
+	Node struct {
+		ProjectV2Item struct {
+			FieldValues projectItemFieldValues `graphql:"fieldValues(first: 100, after: $after)"`
+		} `graphql:"... on ProjectV2Item"`
+	} `graphql:"node(id: $id)"`
+}
+
+// completeProjectItemFieldValues pages through the rest of an item's field values, so values
+// past the first page are not mistaken for unset ones.
+func completeProjectItemFieldValues(ctx context.Context, client *githubv4.Client, node *projectItemNode) error {
+	for node.FieldValues.PageInfo.HasNextPage {
+		var query projectItemFieldValuesQuery
+		if err := client.Query(ctx, &query, map[string]interface{}{
+			"id":    node.ID,
+			"after": node.FieldValues.PageInfo.EndCursor,
+		}); err != nil {
+			return fmt.Errorf("failed to get field values of item %v: %w", node.ID, err)
+		}
+		page := query.Node.ProjectV2Item.FieldValues
+		node.FieldValues.Nodes = append(node.FieldValues.Nodes, page.Nodes...)
+		node.FieldValues.PageInfo = page.PageInfo
+	}
+	return nil
+}
+
+// projectItemContent is the issue, pull request or draft issue behind a project item.
+type projectItemContent struct {
+	Issue struct {
+		ID         githubv4.ID
+		Number     githubv4.Int
+		Title      githubv4.String
+		URL        githubv4.String
+		State      githubv4.String `graphql:"issueState: state"`
+		CreatedAt  githubv4.DateTime
+		ClosedAt   *githubv4.DateTime
+		Repository struct {
+			NameWithOwner githubv4.String
+		}
+		Comments struct {
+			TotalCount githubv4.Int
+		}
+		// LinkedPullRequests are the pull requests that will close the issue, as shown under Development
+		LinkedPullRequests struct {
+			TotalCount githubv4.Int
+		} `graphql:"closedByPullRequestsReferences(first: 1, includeClosedPrs: true)"`
+		Assignees struct {
+			Nodes []struct {
+				Login githubv4.String
+			}
+		} `graphql:"assignees(first: 10)"`
+	} `graphql:"... on Issue"`
+	PullRequest struct {
+		ID         githubv4.ID
+		Number     githubv4.Int
+		Title      githubv4.String
+		URL        githubv4.String
+		State      githubv4.String `graphql:"pullRequestState: state"`
+		CreatedAt  githubv4.DateTime
+		ClosedAt   *githubv4.DateTime
+		Repository struct {
+			NameWithOwner githubv4.String
+		}
+		Comments struct {
+			TotalCount githubv4.Int
+		}
+		Assignees struct {
+			Nodes []struct {
+				Login githubv4.String
+			}
+		} `graphql:"assignees(first: 10)"`
+	} `graphql:"... on PullRequest"`
+	DraftIssue struct {
+		ID        githubv4.ID
+		Title     githubv4.String
+		Body      githubv4.String
+		CreatedAt githubv4.DateTime
+	} `graphql:"... on DraftIssue"`
+}
+
+// projectItemFieldValueNode covers the item field value types that carry user-editable data.
+type projectItemFieldValueNode struct {
+	Typename  githubv4.String `graphql:"__typename"`
+	TextValue struct {
+		Text  githubv4.String
+		Field projectFieldRef
+	} `graphql:"... on ProjectV2ItemFieldTextValue"`
+	NumberValue struct {
+		Number githubv4.Float
+		Field  projectFieldRef
+	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+	DateValue struct {
+		Date  githubv4.String
+		Field projectFieldRef
+	} `graphql:"... on ProjectV2ItemFieldDateValue"`
+	SingleSelectValue struct {
+		Name     githubv4.String
+		OptionID githubv4.String
+		Field    projectFieldRef
+	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	IterationValue struct {
+		Title       githubv4.String
+		IterationID githubv4.String
+		StartDate   githubv4.String
+		Duration    githubv4.Int
+		Field       projectFieldRef
+	} `graphql:"... on ProjectV2ItemFieldIterationValue"`
+}
+
+// projectFieldRef identifies the field a value belongs to.
+type projectFieldRef struct {
+	Common struct {
+		ID   githubv4.ID
+		Name githubv4.String
+	} `graphql:"... on ProjectV2FieldCommon"`
+}
+
+// projectItem is the normalized view of a project item shared by the board-walking tools.
+type projectItem struct {
+	ID         string   `json:"id"`
+	Position   int      `json:"position"`
+	Type       string   `json:"type"`
+	ContentID  string   `json:"content_id,omitempty"`
+	Number     int      `json:"number,omitempty"`
+	Title      string   `json:"title,omitempty"`
+	URL        string   `json:"url,omitempty"`
+	State      string   `json:"state,omitempty"`
+	Repository string   `json:"repository,omitempty"`
+	Assignees  []string `json:"assignees,omitempty"`
+	// CommentCount is nil for draft issues, which cannot be commented on
+	CommentCount *int `json:"comment_count,omitempty"`
+	// LinkedPullRequestCount is only set for issues
+	LinkedPullRequestCount *int                `json:"linked_pull_request_count,omitempty"`
+	CreatedAt              time.Time           `json:"created_at"`
+	UpdatedAt              time.Time           `json:"updated_at"`
+	ClosedAt               *time.Time          `json:"closed_at,omitempty"`
+	FieldValues            []projectFieldValue `json:"field_values,omitempty"`
+	Orphaned               bool                `json:"orphaned,omitempty"`
+	// DraftBody is only set for draft issues; listings leave it out and export_project writes it as body
+	DraftBody string `json:"-"`
+}
+
+// projectFieldValue is a single field value on a project item.
+type projectFieldValue struct {
+	FieldID     string      `json:"field_id"`
+	FieldName   string      `json:"field_name"`
+	Type        string      `json:"type"`
+	Value       interface{} `json:"value"`
+	OptionID    string      `json:"option_id,omitempty"`
+	IterationID string      `json:"iteration_id,omitempty"`
+}
+
+// fieldValue returns the value for the named field, matching the name case-insensitively.
+func (i projectItem) fieldValue(fieldName string) (projectFieldValue, bool) {
+	for _, v := range i.FieldValues {
+		if strings.EqualFold(v.FieldName, fieldName) {
+			return v, true
+		}
+	}
+	return projectFieldValue{}, false
+}
+
+// fieldValueByID returns the value for the field with the given ID.
+func (i projectItem) fieldValueByID(fieldID string) (projectFieldValue, bool) {
+	for _, v := range i.FieldValues {
+		if v.FieldID == fieldID {
+			return v, true
+		}
+	}
+	return projectFieldValue{}, false
+}
+
+// singleSelectName returns the selected option name of a single-select field, or "" when unset.
+func (i projectItem) singleSelectName(fieldName string) string {
+	v, ok := i.fieldValue(fieldName)
+	if !ok || v.Type != "SINGLE_SELECT" {
+		return ""
+	}
+	name, _ := v.Value.(string)
+	return name
+}
+
+// newProjectItem flattens a projectItemNode into a projectItem.
+func newProjectItem(node projectItemNode) projectItem {
+	item := projectItem{
+		ID:        fmt.Sprint(node.ID),
+		Type:      string(node.Type),
+		CreatedAt: node.CreatedAt.Time,
+		UpdatedAt: node.UpdatedAt.Time,
+	}
+
+	// UNDERSTANDING: A null content means the linked issue/PR was deleted; REDACTED items are merely inaccessible
+	if node.Content == nil {
+		item.Orphaned = item.Type != "REDACTED"
+		return item
+	}
+
+	// UNDERSTANDING: Only one content fragment is populated, selected by the item type
+	switch item.Type {
+	case "ISSUE":
+		c := node.Content.Issue
+		item.ContentID = fmt.Sprint(c.ID)
+		item.Number = int(c.Number)
+		item.Title = string(c.Title)
+		item.URL = string(c.URL)
+		item.State = string(c.State)
+		item.Repository = string(c.Repository.NameWithOwner)
+		for _, a := range c.Assignees.Nodes {
+			item.Assignees = append(item.Assignees, string(a.Login))
+		}
+		comments := int(c.Comments.TotalCount)
+		item.CommentCount = &comments
+		linked := int(c.LinkedPullRequests.TotalCount)
+		item.LinkedPullRequestCount = &linked
+		if c.ClosedAt != nil {
+			item.ClosedAt = &c.ClosedAt.Time
+		}
+	case "PULL_REQUEST":
+		c := node.Content.PullRequest
+		item.ContentID = fmt.Sprint(c.ID)
+		item.Number = int(c.Number)
+		item.Title = string(c.Title)
+		item.URL = string(c.URL)
+		item.State = string(c.State)
+		item.Repository = string(c.Repository.NameWithOwner)
+		for _, a := range c.Assignees.Nodes {
+			item.Assignees = append(item.Assignees, string(a.Login))
+		}
+		comments := int(c.Comments.TotalCount)
+		item.CommentCount = &comments
+		if c.ClosedAt != nil {
+			item.ClosedAt = &c.ClosedAt.Time
+		}
+	case "DRAFT_ISSUE":
+		item.ContentID = fmt.Sprint(node.Content.DraftIssue.ID)
+		item.Title = string(node.Content.DraftIssue.Title)
+		item.DraftBody = string(node.Content.DraftIssue.Body)
+	}
+
+	item.FieldValues = newProjectFieldValues(node.FieldValues.Nodes)
+
+	return item
+}
+
+// newProjectFieldValues normalizes an item's field values, skipping built-in values such as title or labels.
+func newProjectFieldValues(nodes []projectItemFieldValueNode) []projectFieldValue {
+	var values []projectFieldValue
+	for _, v := range nodes {
+		var value projectFieldValue
+		var field projectFieldRef
+		switch v.Typename {
+		case "ProjectV2ItemFieldTextValue":
+			field = v.TextValue.Field
+			value = projectFieldValue{Type: "TEXT", Value: string(v.TextValue.Text)}
+		case "ProjectV2ItemFieldNumberValue":
+			field = v.NumberValue.Field
+			value = projectFieldValue{Type: "NUMBER", Value: float64(v.NumberValue.Number)}
+		case "ProjectV2ItemFieldDateValue":
+			field = v.DateValue.Field
+			value = projectFieldValue{Type: "DATE", Value: string(v.DateValue.Date)}
+		case "ProjectV2ItemFieldSingleSelectValue":
+			field = v.SingleSelectValue.Field
+			value = projectFieldValue{
+				Type:     "SINGLE_SELECT",
+				Value:    string(v.SingleSelectValue.Name),
+				OptionID: string(v.SingleSelectValue.OptionID),
+			}
+		case "ProjectV2ItemFieldIterationValue":
+			field = v.IterationValue.Field
+			value = projectFieldValue{
+				Type:        "ITERATION",
+				Value:       string(v.IterationValue.Title),
+				IterationID: string(v.IterationValue.IterationID),
+			}
+		default:
+			// Built-in values such as title, labels or repository are exposed through content instead
+			continue
+		}
+		value.FieldID = fmt.Sprint(field.Common.ID)
+		value.FieldName = string(field.Common.Name)
+		values = append(values, value)
+	}
+	return values
+}
+
+// defaultMaxProjectItems caps how many items board-walking tools fetch when max_items is not given.
+const defaultMaxProjectItems = 1000
+
+// projectItemsResult holds the items fetched from a board and whether the walk stopped at the cap.
+type projectItemsResult struct {
+	Items      []projectItem
+	Truncated  bool
+	NextCursor string
+}
+
+// withMaxItems adds the max_items cap parameter shared by tools that walk a whole board.
+func withMaxItems() mcp.ToolOption {
+	return mcp.WithNumber("max_items",
+		mcp.Description(fmt.Sprintf("Maximum number of items to fetch before stopping (default: %d). Large boards are truncated at this cap", defaultMaxProjectItems)),
+		mcp.Min(1),
+	)
+}
+
+// addTruncation reports a capped board walk on a tool response.
+func (r projectItemsResult) addTruncation(response map[string]interface{}) {
+	response["truncated"] = r.Truncated
+	if r.Truncated {
+		response["fetched_items"] = len(r.Items)
+		response["next_cursor"] = r.NextCursor
+	}
+}
+
+// fetchAllProjectItems walks a project's items page by page and returns them normalized.
+// UNDERSTANDING: Walking stops once maxItems are fetched so very large boards stay cheap;
+// the cursor of the last fetched item is returned so callers can tell where the walk stopped
+func fetchAllProjectItems(ctx context.Context, client *githubv4.Client, projectID string, maxItems int) (projectItemsResult, error) {
+	return fetchProjectItemsAfter(ctx, client, projectID, "", maxItems)
+}
+
+// fetchProjectItemsAfter is fetchAllProjectItems starting after a cursor, e.g. the next_cursor of a truncated walk.
+// Cursors carry the number of items before them (see encodeProjectItemsCursor), so positions continue across calls.
+func fetchProjectItemsAfter(ctx context.Context, client *githubv4.Client, projectID string, cursor string, maxItems int) (projectItemsResult, error) {
+	if maxItems <= 0 {
+		maxItems = defaultMaxProjectItems
+	}
+	offset := 0
+	var after *githubv4.String
+	if cursor != "" {
+		var endCursor string
+		var err error
+		if offset, endCursor, err = decodeProjectItemsCursor(cursor); err != nil {
+			return projectItemsResult{}, err
+		}
+		after = githubv4.NewString(githubv4.String(endCursor))
+	}
+
+	var result projectItemsResult
+	for {
+		// VERIFIED: The last page is sized to the remaining budget so its endCursor is exactly the cap
+		var query projectItemsQuery
+		if err := client.Query(ctx, &query, map[string]interface{}{
+			"projectId": githubv4.ID(projectID),
+			"first":     githubv4.Int(min(100, maxItems-len(result.Items))), // #nosec G115 - bounded by 100
+			"after":     after,
+		}); err != nil {
+			return projectItemsResult{}, err
+		}
+
+		for _, node := range query.Node.ProjectV2.Items.Nodes {
+			if err := completeProjectItemFieldValues(ctx, client, &node); err != nil {
+				return projectItemsResult{}, err
+			}
+			item := newProjectItem(node)
+			item.Position = offset + len(result.Items) + 1
+			result.Items = append(result.Items, item)
+		}
+
+		pageInfo := query.Node.ProjectV2.Items.PageInfo
+		if !pageInfo.HasNextPage {
+			return result, nil
+		}
+		if len(result.Items) >= maxItems {
+			result.Truncated = true
+			result.NextCursor = encodeProjectItemsCursor(offset+len(result.Items), string(pageInfo.EndCursor))
+			return result, nil
+		}
+		cursor := pageInfo.EndCursor
+		after = &cursor
+	}
+}
+
+// roundTo2 rounds a float to two decimal places for readable metrics output.
+func roundTo2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
+// UNDERSTANDING: Compute flow metrics (average age and throughput) for a project board
+// EXPECTS: project_id, done_status (Status option that means "done"), optional window_days and status_field_name
+// RETURNS: Average item age for open and done items, plus throughput within the window
+// INTEGRATION: Walks the board with fetchAllProjectItems; done time is the linked issue/PR closedAt
+func GetProjectFlowMetrics(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_flow_metrics",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_FLOW_METRICS_DESCRIPTION", "Compute flow metrics for a GitHub Projects v2 board: average item age (time since the item was added, up to its close time for done items) and throughput (done items closed within the window). Done time is taken from the linked issue or pull request's close date; done items without one are reported separately.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_FLOW_METRICS_USER_TITLE", "Get project flow metrics"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("done_status",
+				mcp.Required(),
+				mcp.Description("Status option name that marks an item as done (e.g., 'Done')"),
+			),
+			mcp.WithNumber("window_days",
+				mcp.Description("Throughput window in days, counting back from now (default: 14)"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Name of the single-select status field (default: 'Status')"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string `mapstructure:"project_id"`
+				DoneStatus      string `mapstructure:"done_status"`
+				WindowDays      *int   `mapstructure:"window_days"`
+				StatusFieldName string `mapstructure:"status_field_name"`
+				MaxItems        int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.WindowDays == nil {
+				defaultWindow := 14
+				params.WindowDays = &defaultWindow
+			}
+			if *params.WindowDays <= 0 {
+				return mcp.NewToolResultError("window_days must be greater than 0"), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+			items := fetched.Items
+
+			// UNDERSTANDING: Age runs from when the item was added to the board until now (open) or until close (done)
+			// VERIFIED: Done items with no closedAt (drafts, issues moved to Done but left open) have no derivable done time
+			now := time.Now()
+			windowStart := now.AddDate(0, 0, -*params.WindowDays)
+			var openAges, doneAges []float64
+			doneItems, throughput, withoutDoneTime := 0, 0, 0
+			for _, item := range items {
+				if item.Orphaned {
+					continue
+				}
+				if !strings.EqualFold(item.singleSelectName(params.StatusFieldName), params.DoneStatus) {
+					openAges = append(openAges, now.Sub(item.CreatedAt).Hours()/24)
+					continue
+				}
+
+				doneItems++
+				if item.ClosedAt == nil {
+					withoutDoneTime++
+					continue
+				}
+				doneAges = append(doneAges, item.ClosedAt.Sub(item.CreatedAt).Hours()/24)
+				if !item.ClosedAt.Before(windowStart) {
+					throughput++
+				}
+			}
+
+			average := func(values []float64) float64 {
+				if len(values) == 0 {
+					return 0
+				}
+				total := 0.0
+				for _, v := range values {
+					total += v
+				}
+				return roundTo2(total / float64(len(values)))
+			}
+
+			response := map[string]interface{}{
+				"project_id":              params.ProjectID,
+				"done_status":             params.DoneStatus,
+				"window_days":             *params.WindowDays,
+				"total_items":             len(items),
+				"open_items":              len(openAges),
+				"done_items":              doneItems,
+				"average_age_days":        average(append(append([]float64{}, openAges...), doneAges...)),
+				"average_open_age_days":   average(openAges),
+				"average_done_age_days":   average(doneAges),
+				"throughput":              throughput,
+				"items_without_done_time": withoutDoneTime,
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: List every item on a GitHub Projects v2 board with its content and field values
+// EXPECTS: project_id (Projects v2 node ID), optional first and after to fetch one page at a time
+// RETURNS: Normalized items and page_info; items whose content was deleted are reduced to their ID and flagged orphaned
+// INTEGRATION: Gives agents the item IDs needed by update_project_item_status and cleanup tools
+func ListProjectItems(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_items",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_ITEMS_DESCRIPTION", "List all items on a GitHub Projects v2 board in manual board order, including their linked issue/PR/draft details and field values. Each item has a 1-based position that can be used to replicate the board order. Items whose issue or pull request was deleted are returned as {id, orphaned: true} so they can be cleaned up.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_ITEMS_USER_TITLE", "List project items"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("sort_by",
+				mcp.Description("Order of the returned items: 'position' (board order, default) or 'comments' (most commented first; draft issues last)"),
+				mcp.Enum("position", "comments"),
+			),
+			mcp.WithNumber("first",
+				mcp.Description("Return a single page of this many items (1-100) instead of the whole board; continue with page_info.end_cursor as after"),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
+			mcp.WithString("after",
+				mcp.Description("Cursor to continue from: page_info.end_cursor of a previous call, or next_cursor of a truncated one"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				SortBy    string `mapstructure:"sort_by"`
+				First     int    `mapstructure:"first"`
+				After     string `mapstructure:"after"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.SortBy != "" && params.SortBy != "position" && params.SortBy != "comments" {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid sort_by %q: must be one of position, comments", params.SortBy)), nil
+			}
+			if params.First < 0 || params.First > 100 {
+				return mcp.NewToolResultError("first must be between 1 and 100"), nil
+			}
+			// UNDERSTANDING: A page is a board walk capped at first items, so truncation doubles as the page info
+			maxItems := params.MaxItems
+			if params.First > 0 {
+				maxItems = params.First
+			}
+			if params.After != "" {
+				if _, _, err := decodeProjectItemsCursor(params.After); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchProjectItemsAfter(ctx, client, params.ProjectID, params.After, maxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+			items := fetched.Items
+
+			// UNDERSTANDING: Items without a comment count (drafts, orphans) sort after every commented item
+			if params.SortBy == "comments" {
+				commentCount := func(item projectItem) int {
+					if item.CommentCount == nil {
+						return -1
+					}
+					return *item.CommentCount
+				}
+				sort.SliceStable(items, func(i, j int) bool {
+					return commentCount(items[i]) > commentCount(items[j])
+				})
+			}
+			output, orphaned := projectItemsOutput(items)
+
+			response := map[string]interface{}{
+				"project_id":     params.ProjectID,
+				"total_count":    len(items),
+				"orphaned_count": orphaned,
+				"items":          output,
+				"page_info": map[string]interface{}{
+					"has_next_page": fetched.Truncated,
+					"end_cursor":    fetched.NextCursor,
+				},
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// projectItemsOutput prepares items for a list response.
+// UNDERSTANDING: Orphaned items carry no useful content, so only the ID is returned for cleanup
+func projectItemsOutput(items []projectItem) ([]interface{}, int) {
+	output := make([]interface{}, 0, len(items))
+	orphaned := 0
+	for _, item := range items {
+		if item.Orphaned {
+			orphaned++
+			output = append(output, map[string]interface{}{
+				"id":       item.ID,
+				"orphaned": true,
+			})
+			continue
+		}
+		output = append(output, item)
+	}
+	return output, orphaned
+}
+
+// UpdateProjectV2FieldInput mirrors GitHub's updateProjectV2Field input, which the pinned githubv4 release predates.
+// VERIFIED: The Go type name is sent as the GraphQL variable type, so it must match the schema exactly
+type UpdateProjectV2FieldInput struct {
+	FieldID                githubv4.ID                                `json:"fieldId"`
+	Name                   *githubv4.String                           `json:"name,omitempty"`
+	SingleSelectOptions    *[]ProjectV2SingleSelectFieldOptionInput   `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *ProjectV2IterationFieldConfigurationInput `json:"iterationConfiguration,omitempty"`
+}
+
+// ProjectV2SingleSelectFieldOptionInput mirrors GitHub's option input including the id, which the pinned githubv4 release lacks.
+// VERIFIED: updateProjectV2Field replaces the whole option list; options sent without their id are recreated and cleared on every item
+type ProjectV2SingleSelectFieldOptionInput struct {
+	ID          *githubv4.String                               `json:"id,omitempty"`
+	Name        githubv4.String                                `json:"name"`
+	Color       githubv4.ProjectV2SingleSelectFieldOptionColor `json:"color"`
+	Description githubv4.String                                `json:"description"`
+}
+
+// newSingleSelectOptionInputs drops option IDs for createProjectV2Field, where every option is new.
+func newSingleSelectOptionInputs(options []ProjectV2SingleSelectFieldOptionInput) []githubv4.ProjectV2SingleSelectFieldOptionInput {
+	inputs := make([]githubv4.ProjectV2SingleSelectFieldOptionInput, 0, len(options))
+	for _, option := range options {
+		inputs = append(inputs, githubv4.ProjectV2SingleSelectFieldOptionInput{
+			Name:        option.Name,
+			Color:       option.Color,
+			Description: option.Description,
+		})
+	}
+	return inputs
+}
+
+// ProjectV2IterationFieldConfigurationInput describes the full iteration setup of an iteration field.
+type ProjectV2IterationFieldConfigurationInput struct {
+	Duration   githubv4.Int         `json:"duration"`
+	StartDate  githubv4.Date        `json:"startDate"`
+	Iterations []ProjectV2Iteration `json:"iterations"`
+}
+
+// ProjectV2Iteration is a single iteration within ProjectV2IterationFieldConfigurationInput.
+// VERIFIED: GitHub's iteration input has no id, so every iteration sent is recreated with a new ID
+type ProjectV2Iteration struct {
+	Title     githubv4.String `json:"title"`
+	StartDate githubv4.Date   `json:"startDate"`
+	Duration  githubv4.Int    `json:"duration"`
+}
+
+// projectIterationConfiguration is the iteration setup as read back from a ProjectV2IterationField.
+type projectIterationConfiguration struct {
+	Duration            githubv4.Int
+	StartDay            githubv4.Int
+	Iterations          []projectIterationNode
+	CompletedIterations []projectIterationNode
+}
+
+// projectIterationNode is a single configured iteration.
+type projectIterationNode struct {
+	ID        githubv4.String
+	Title     githubv4.String
+	StartDate githubv4.String
+	Duration  githubv4.Int
+}
+
+// UNDERSTANDING: Change the default sprint length of an iteration field and optionally add iterations
+// EXPECTS: field_id (iteration field node ID), duration_days, optional start_date and new_iterations
+// RETURNS: The field's iteration list after the update and which new iteration ID replaced each old one
+// INTEGRATION: updateProjectV2Field replaces the whole iteration list and cannot keep iteration IDs, so completed
+// and current iterations are re-sent and the call is refused unless confirm acknowledges the reset assignments
+func UpdateProjectIterationSettings(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_iteration_settings",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_ITERATION_SETTINGS_DESCRIPTION", "Update the iteration (sprint) settings of a GitHub Projects v2 iteration field: change the default duration and optionally append new iterations. Existing and completed iterations are re-sent with their titles, dates and durations, but GitHub recreates every iteration with a new ID, so all items lose their iteration value. Requires confirm: true.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_PROJECT_ITERATION_SETTINGS_USER_TITLE", "Update project iteration settings"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Iteration field ID (PVTIF_xxxx format)"),
+			),
+			mcp.WithBoolean("confirm",
+				mcp.Required(),
+				mcp.Description("Must be true: acknowledges that every item's iteration value is cleared because iterations get new IDs"),
+			),
+			mcp.WithNumber("duration_days",
+				mcp.Required(),
+				mcp.Description("New default iteration length in days"),
+				mcp.Min(1),
+			),
+			mcp.WithString("start_date",
+				mcp.Description("Start date of the iteration cycle in YYYY-MM-DD format (default: start of the first existing iteration, or today)"),
+			),
+			mcp.WithArray("new_iterations",
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"title", "start_date"},
+						"properties": map[string]interface{}{
+							"title": map[string]interface{}{
+								"type":        "string",
+								"description": "Iteration title",
+							},
+							"start_date": map[string]interface{}{
+								"type":        "string",
+								"description": "Iteration start date in YYYY-MM-DD format",
+							},
+							"duration_days": map[string]interface{}{
+								"type":        "number",
+								"description": "Iteration length in days (default: duration_days)",
+							},
+						},
+					}),
+				mcp.Description("Optional iterations to append, each with title, start_date and optional duration_days"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				FieldID       string `mapstructure:"field_id"`
+				Confirm       bool   `mapstructure:"confirm"`
+				DurationDays  int    `mapstructure:"duration_days"`
+				StartDate     string `mapstructure:"start_date"`
+				NewIterations []struct {
+					Title        string `mapstructure:"title"`
+					StartDate    string `mapstructure:"start_date"`
+					DurationDays int    `mapstructure:"duration_days"`
+				} `mapstructure:"new_iterations"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.DurationDays <= 0 {
+				return mcp.NewToolResultError("duration_days must be greater than 0"), nil
+			}
+			if !params.Confirm {
+				return mcp.NewToolResultError("updating iteration settings recreates every iteration with a new ID, so all items lose their iteration value; set confirm to true to proceed"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var fieldQuery struct {
+				Node struct {
+					ProjectV2IterationField struct {
+						ID            githubv4.ID
+						Name          githubv4.String
+						Configuration projectIterationConfiguration
+					} `graphql:"... on ProjectV2IterationField"`
+				} `graphql:"node(id: $id)"`
+			}
+			if err := client.Query(ctx, &fieldQuery, map[string]interface{}{
+				"id": githubv4.ID(params.FieldID),
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get iteration field", err), nil
+			}
+			if fieldQuery.Node.ProjectV2IterationField.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("field %s not found or is not an iteration field", params.FieldID)), nil
+			}
+
+			// UNDERSTANDING: Re-send completed and current iterations, oldest first, so no sprint is dropped from the list
+			parseDate := func(name, value string) (githubv4.Date, error) {
+				parsed, err := time.Parse("2006-01-02", value)
+				if err != nil {
+					return githubv4.Date{}, fmt.Errorf("invalid %s %q: expected YYYY-MM-DD", name, value)
+				}
+				return githubv4.Date{Time: parsed}, nil
+			}
+
+			configuration := fieldQuery.Node.ProjectV2IterationField.Configuration
+			existingIterations := append(slices.Clone(configuration.CompletedIterations), configuration.Iterations...)
+			slices.SortStableFunc(existingIterations, func(a, b projectIterationNode) int {
+				return cmp.Compare(a.StartDate, b.StartDate)
+			})
+
+			var iterations []ProjectV2Iteration
+			for _, existing := range existingIterations {
+				startDate, err := parseDate("existing iteration start date", string(existing.StartDate))
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				iterations = append(iterations, ProjectV2Iteration{
+					Title:     existing.Title,
+					StartDate: startDate,
+					Duration:  existing.Duration,
+				})
+			}
+			for _, added := range params.NewIterations {
+				startDate, err := parseDate("new iteration start_date", added.StartDate)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				duration := added.DurationDays
+				if duration <= 0 {
+					duration = params.DurationDays
+				}
+				iterations = append(iterations, ProjectV2Iteration{
+					Title:     githubv4.String(added.Title),
+					StartDate: startDate,
+					Duration:  githubv4.Int(duration),
+				})
+			}
+			if iterations == nil {
+				iterations = []ProjectV2Iteration{}
+			}
+
+			cycleStart := githubv4.Date{Time: time.Now().UTC().Truncate(24 * time.Hour)}
+			switch {
+			case params.StartDate != "":
+				if cycleStart, err = parseDate("start_date", params.StartDate); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+			case len(iterations) > 0:
+				cycleStart = iterations[0].StartDate
+			}
+
+			var updateFieldMutation struct {
+				UpdateProjectV2Field struct {
+					ProjectV2Field struct {
+						ProjectV2IterationField struct {
+							ID            githubv4.ID
+							Name          githubv4.String
+							Configuration projectIterationConfiguration
+						} `graphql:"... on ProjectV2IterationField"`
+					}
+				} `graphql:"updateProjectV2Field(input: $input)"`
+			}
+
+			if err := client.Mutate(ctx, &updateFieldMutation, UpdateProjectV2FieldInput{
+				FieldID: githubv4.ID(params.FieldID),
+				IterationConfiguration: &ProjectV2IterationFieldConfigurationInput{
+					Duration:   githubv4.Int(params.DurationDays),
+					StartDate:  cycleStart,
+					Iterations: iterations,
+				},
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to update iteration settings", err), nil
+			}
+
+			updated := updateFieldMutation.UpdateProjectV2Field.ProjectV2Field.ProjectV2IterationField
+			iterationList := make([]map[string]interface{}, 0, len(updated.Configuration.Iterations))
+			for _, iteration := range updated.Configuration.Iterations {
+				iterationList = append(iterationList, map[string]interface{}{
+					"id":         iteration.ID,
+					"title":      iteration.Title,
+					"start_date": iteration.StartDate,
+					"duration":   int(iteration.Duration),
+				})
+			}
+			completedList := make([]map[string]interface{}, 0, len(updated.Configuration.CompletedIterations))
+			for _, iteration := range updated.Configuration.CompletedIterations {
+				completedList = append(completedList, map[string]interface{}{
+					"id":         iteration.ID,
+					"title":      iteration.Title,
+					"start_date": iteration.StartDate,
+					"duration":   int(iteration.Duration),
+				})
+			}
+
+			// VERIFIED: Old and new iterations are paired by title and start date so callers can reassign items
+			replacedIDs := map[string]string{}
+			for _, old := range existingIterations {
+				for _, iteration := range append(slices.Clone(updated.Configuration.CompletedIterations), updated.Configuration.Iterations...) {
+					if iteration.Title == old.Title && iteration.StartDate == old.StartDate {
+						replacedIDs[string(old.ID)] = string(iteration.ID)
+						break
+					}
+				}
+			}
+
+			response := map[string]interface{}{
+				"success":                true,
+				"message":                "Iteration settings updated successfully; items must be reassigned to the new iteration IDs",
+				"field_id":               updated.ID,
+				"name":                   updated.Name,
+				"duration":               int(updated.Configuration.Duration),
+				"iterations":             iterationList,
+				"completed_iterations":   completedList,
+				"replaced_iteration_ids": replacedIDs,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// parseProjectContentURL splits a GitHub issue, pull request or discussion URL into owner, repo, kind and number.
+// VERIFIED: kind is the URL path segment: "issues", "pull" or "discussions"
+func parseProjectContentURL(rawURL string) (owner, repo, kind string, number int, err error) {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", "", "", 0, fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+
+	segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if parsed.Host == "" || len(segments) < 4 {
+		return "", "", "", 0, fmt.Errorf("invalid URL %q: expected https://github.com/owner/repo/{issues|pull|discussions}/number", rawURL)
+	}
+
+	kind = segments[2]
+	if kind != "issues" && kind != "pull" && kind != "discussions" {
+		return "", "", "", 0, fmt.Errorf("invalid URL %q: unsupported content type %q", rawURL, kind)
+	}
+
+	number, err = strconv.Atoi(segments[3])
+	if err != nil || number <= 0 {
+		return "", "", "", 0, fmt.Errorf("invalid URL %q: %q is not a valid number", rawURL, segments[3])
+	}
+
+	return segments[0], segments[1], kind, number, nil
+}
+
+// UNDERSTANDING: Resolve a discussion URL to its node ID and try to add it to a Projects v2 board
+// EXPECTS: project_id, discussion_url (https://github.com/owner/repo/discussions/N)
+// RETURNS: The new item ID, or a clear error if the project rejects discussions
+// INTEGRATION: Companion to AddItemToProject for content that is not an issue or pull request
+func AddDiscussionToProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("add_discussion_to_project",
+			mcp.WithDescription(t("TOOL_ADD_DISCUSSION_TO_PROJECT_DESCRIPTION", "Add a GitHub Discussion to a Projects v2 board by its URL. GitHub may only accept issues and pull requests as project items; in that case a clear error is returned.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_DISCUSSION_TO_PROJECT_USER_TITLE", "Add discussion to project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID (use list_user_projects to find this)"),
+			),
+			mcp.WithString("discussion_url",
+				mcp.Required(),
+				mcp.Description("Full GitHub URL of the discussion (e.g., 'https://github.com/owner/repo/discussions/42')"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID     string `mapstructure:"project_id"`
+				DiscussionURL string `mapstructure:"discussion_url"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			owner, repo, kind, number, err := parseProjectContentURL(params.DiscussionURL)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if kind != "discussions" {
+				return mcp.NewToolResultError(fmt.Sprintf("%s is not a discussion URL; use add_item_to_project for issues and pull requests", params.DiscussionURL)), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// UNDERSTANDING: Projects only take node IDs, so resolve the discussion number first
+			var discussionQuery struct {
+				Repository struct {
+					Discussion struct {
+						ID githubv4.ID
+					} `graphql:"discussion(number: $number)"`
+				} `graphql:"repository(owner: $owner, name: $repo)"`
+			}
+			if err := client.Query(ctx, &discussionQuery, map[string]interface{}{
+				"owner":  githubv4.String(owner),
+				"repo":   githubv4.String(repo),
+				"number": githubv4.Int(number), // #nosec G115 - discussion numbers are always small positive integers
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to resolve discussion", err), nil
+			}
+			discussionID := discussionQuery.Repository.Discussion.ID
+			if discussionID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("discussion %s/%s#%d not found", owner, repo, number)), nil
+			}
+
+			var addItemMutation struct {
+				AddProjectV2ItemById struct {
+					Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"addProjectV2ItemById(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &addItemMutation, githubv4.AddProjectV2ItemByIdInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				ContentID: discussionID,
+			}, nil); err != nil {
+				message := "failed to add discussion to project"
+				if isUnsupportedProjectContent(err) {
+					message += "; discussions cannot be added to this project (GitHub Projects v2 only accepts issues and pull requests as items)"
+				}
+				return projectGraphQLErrorResult(ctx, message, err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":       true,
+				"message":       "Discussion successfully added to project",
+				"item_id":       addItemMutation.AddProjectV2ItemById.Item.ID,
+				"discussion_id": discussionID,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// hasValue reports whether a field value is non-empty.
+func (v projectFieldValue) hasValue() bool {
+	switch value := v.Value.(type) {
+	case string:
+		return value != ""
+	case nil:
+		return false
+	default:
+		return true
+	}
+}
+
+// UNDERSTANDING: Count how many items on a board have a value set for a given field
+// EXPECTS: project_id, field_id (custom field node ID)
+// RETURNS: Number of items with and without a value for the field
+// INTEGRATION: Lets admins gauge the impact before deleting or reworking a field
+func GetProjectFieldUsage(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_field_usage",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_FIELD_USAGE_DESCRIPTION", "Count how many items on a GitHub Projects v2 board have a non-empty value for a custom field (text, number, date, single select or iteration). Use this before deleting a field to see how much data would be lost.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_FIELD_USAGE_USER_TITLE", "Get project field usage"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Project field ID to count usage for"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				FieldID   string `mapstructure:"field_id"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+			items := fetched.Items
+
+			used := 0
+			fieldName := ""
+			for _, item := range items {
+				for _, v := range item.FieldValues {
+					if v.FieldID != params.FieldID || !v.hasValue() {
+						continue
+					}
+					fieldName = v.FieldName
+					used++
+					break
+				}
+			}
+
+			response := map[string]interface{}{
+				"project_id":          params.ProjectID,
+				"field_id":            params.FieldID,
+				"total_items":         len(items),
+				"items_with_value":    used,
+				"items_without_value": len(items) - used,
+			}
+			fetched.addTruncation(response)
+			if fieldName != "" {
+				response["field_name"] = fieldName
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// projectSingleSelectOption is a configured option of a single-select field.
+type projectSingleSelectOption struct {
+	ID          githubv4.String
+	Name        githubv4.String
+	Color       githubv4.ProjectV2SingleSelectFieldOptionColor
+	Description githubv4.String
+}
+
+// projectSingleSelectField is a single-select field with its options.
+type projectSingleSelectField struct {
+	ID      githubv4.ID
+	Name    githubv4.String
+	Options []projectSingleSelectOption
+}
+
+// optionInputs converts the field's options back into inputs, keeping their IDs so an update preserves them and their item values.
+func (f projectSingleSelectField) optionInputs() []ProjectV2SingleSelectFieldOptionInput {
+	inputs := make([]ProjectV2SingleSelectFieldOptionInput, 0, len(f.Options))
+	for _, option := range f.Options {
+		inputs = append(inputs, ProjectV2SingleSelectFieldOptionInput{
+			ID:          githubv4.NewString(option.ID),
+			Name:        option.Name,
+			Color:       option.Color,
+			Description: option.Description,
+		})
+	}
+	return inputs
+}
+
+// fetchSingleSelectField looks up a single-select field and its options by node ID.
+func fetchSingleSelectField(ctx context.Context, client *githubv4.Client, fieldID string) (projectSingleSelectField, error) {
+	var query struct {
+		Node struct {
+			ProjectV2SingleSelectField projectSingleSelectField `graphql:"... on ProjectV2SingleSelectField"`
+		} `graphql:"node(id: $id)"`
+	}
+	if err := client.Query(ctx, &query, map[string]interface{}{
+		"id": githubv4.ID(fieldID),
+	}); err != nil {
+		return projectSingleSelectField{}, err
+	}
+	if query.Node.ProjectV2SingleSelectField.ID == nil {
+		return projectSingleSelectField{}, fmt.Errorf("field %s not found or is not a single-select field", fieldID)
+	}
+	return query.Node.ProjectV2SingleSelectField, nil
+}
+
+// UNDERSTANDING: Copy single-select options from one field to another (e.g., align Priority across projects)
+// EXPECTS: source_field_id and target_field_id (single-select field node IDs)
+// RETURNS: The options that were added and those the target already had
+// INTEGRATION: Existing target options are re-sent with their IDs because updateProjectV2Field replaces the option list
+func CopyProjectFieldOptions(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("copy_project_field_options",
+			mcp.WithDescription(t("TOOL_COPY_PROJECT_FIELD_OPTIONS_DESCRIPTION", "Copy the options of a GitHub Projects v2 single-select field to another single-select field. Options missing from the target (matched by name, case-insensitive) are appended with the source color and description; existing target options are kept.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_COPY_PROJECT_FIELD_OPTIONS_USER_TITLE", "Copy project field options"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("source_field_id",
+				mcp.Required(),
+				mcp.Description("Single-select field ID to copy options from (PVTSSF_xxxx format)"),
+			),
+			mcp.WithString("target_field_id",
+				mcp.Required(),
+				mcp.Description("Single-select field ID to add missing options to (PVTSSF_xxxx format)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				SourceFieldID string `mapstructure:"source_field_id"`
+				TargetFieldID string `mapstructure:"target_field_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			source, err := fetchSingleSelectField(ctx, client, params.SourceFieldID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get source field", err), nil
+			}
+			target, err := fetchSingleSelectField(ctx, client, params.TargetFieldID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get target field", err), nil
+			}
+
+			existing := make(map[string]bool, len(target.Options))
+			for _, option := range target.Options {
+				existing[strings.ToLower(string(option.Name))] = true
+			}
+
+			options := target.optionInputs()
+			added := []string{}
+			skipped := []string{}
+			for _, option := range source.Options {
+				if existing[strings.ToLower(string(option.Name))] {
+					skipped = append(skipped, string(option.Name))
+					continue
+				}
+				existing[strings.ToLower(string(option.Name))] = true
+				added = append(added, string(option.Name))
+				options = append(options, ProjectV2SingleSelectFieldOptionInput{
+					Name:        option.Name,
+					Color:       option.Color,
+					Description: option.Description,
+				})
+			}
+
+			response := map[string]interface{}{
+				"success":         true,
+				"source_field_id": params.SourceFieldID,
+				"target_field_id": params.TargetFieldID,
+				"added_options":   added,
+				"skipped_options": skipped,
+			}
+
+			// VERIFIED: Nothing to add means no mutation, so the target field is left untouched
+			if len(added) == 0 {
+				response["message"] = "Target field already has all source options"
+			} else {
+				var updateFieldMutation struct {
+					UpdateProjectV2Field struct {
+						ProjectV2Field struct {
+							ProjectV2SingleSelectField projectSingleSelectField `graphql:"... on ProjectV2SingleSelectField"`
+						}
+					} `graphql:"updateProjectV2Field(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &updateFieldMutation, UpdateProjectV2FieldInput{
+					FieldID:             githubv4.ID(params.TargetFieldID),
+					SingleSelectOptions: &options,
+				}, nil); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to update target field options", err), nil
+				}
+				response["message"] = fmt.Sprintf("Copied %d option(s) to %s", len(added), target.Name)
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Rename a project field without touching its options or values
+// EXPECTS: field_id and new_name
+// RETURNS: The field as it reads after the rename
+// INTEGRATION: Sends only the name so updateProjectV2Field leaves options and iterations as they are
+func RenameProjectField(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("rename_project_field",
+			mcp.WithDescription(t("TOOL_RENAME_PROJECT_FIELD_DESCRIPTION", "Rename a field on a GitHub Projects v2 board. Options, iterations and item values are kept.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_RENAME_PROJECT_FIELD_USER_TITLE", "Rename project field"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Project field ID to rename"),
+			),
+			mcp.WithString("new_name",
+				mcp.Required(),
+				mcp.Description("New name for the field"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				FieldID string `mapstructure:"field_id"`
+				NewName string `mapstructure:"new_name"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if strings.TrimSpace(params.NewName) == "" {
+				return mcp.NewToolResultError("new_name must not be empty"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var updateFieldMutation struct {
+				UpdateProjectV2Field struct {
+					ProjectV2Field struct {
+						Common struct {
+							ID       githubv4.ID
+							Name     githubv4.String
+							DataType githubv4.String
+						} `graphql:"... on ProjectV2FieldCommon"`
+					}
+				} `graphql:"updateProjectV2Field(input: $input)"`
+			}
+
+			newName := githubv4.String(params.NewName)
+			if err := client.Mutate(ctx, &updateFieldMutation, UpdateProjectV2FieldInput{
+				FieldID: githubv4.ID(params.FieldID),
+				Name:    &newName,
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to rename field", err), nil
+			}
+
+			updated := updateFieldMutation.UpdateProjectV2Field.ProjectV2Field.Common
+			response := map[string]interface{}{
+				"success":   true,
+				"message":   fmt.Sprintf("Field renamed to %s", updated.Name),
+				"field_id":  updated.ID,
+				"name":      updated.Name,
+				"data_type": updated.DataType,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// fetchProjectItem looks up a single project item by node ID and normalizes it.
+func fetchProjectItem(ctx context.Context, client *githubv4.Client, itemID string) (projectItem, error) {
+	var query struct {
+		Node struct {
+			ProjectV2Item projectItemNode `graphql:"... on ProjectV2Item"`
+		} `graphql:"node(id: $id)"`
+	}
+	if err := client.Query(ctx, &query, map[string]interface{}{
+		"id": githubv4.ID(itemID),
+	}); err != nil {
+		return projectItem{}, err
+	}
+	if query.Node.ProjectV2Item.ID == nil {
+		return projectItem{}, fmt.Errorf("item %s not found or is not a project item", itemID)
+	}
+	if err := completeProjectItemFieldValues(ctx, client, &query.Node.ProjectV2Item); err != nil {
+		return projectItem{}, err
+	}
+	return newProjectItem(query.Node.ProjectV2Item), nil
+}
+
+// projectView is a saved view of a project, including its filter query.
+type projectView struct {
+	ID     githubv4.ID                  `json:"id"`
+	Name   githubv4.String              `json:"name"`
+	Number githubv4.Int                 `json:"number"`
+	Layout githubv4.ProjectV2ViewLayout `json:"layout"`
+	Filter githubv4.String              `json:"filter"`
+}
+
+// unsupportedViewQualifiers are filter qualifiers whose data is not fetched for project items.
+var unsupportedViewQualifiers = map[string]bool{
+	"label": true, "milestone": true, "reviewers": true, "type": true, "reason": true,
+	"author": true, "mentions": true, "created": true, "updated": true, "last-updated": true,
+	"parent-issue": true, "sub-issues-progress": true, "linked-pull-requests": true,
+}
+
+// splitViewFilter breaks a view filter into terms, keeping quoted sections together and dropping the quotes.
+func splitViewFilter(filter string) []string {
+	var terms []string
+	var current strings.Builder
+	quoted := false
+	for _, r := range filter {
+		switch {
+		case r == '"':
+			quoted = !quoted
+		case (r == ' ' || r == '\t') && !quoted:
+			if current.Len() > 0 {
+				terms = append(terms, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		terms = append(terms, current.String())
+	}
+	return terms
+}
+
+// matchViewFilterTerm evaluates a single qualifier:values term against an item.
+// It returns whether the item matches and whether the term could be evaluated at all.
+func matchViewFilterTerm(item projectItem, qualifier string, values []string) (bool, bool) {
+	matchAny := func(match func(value string) (bool, bool)) (bool, bool) {
+		for _, value := range values {
+			matched, supported := match(value)
+			if !supported {
+				return false, false
+			}
+			if matched {
+				return true, true
+			}
+		}
+		return false, true
+	}
+
+	switch qualifier {
+	case "is":
+		return matchAny(func(value string) (bool, bool) {
+			switch strings.ToLower(value) {
+			case "open":
+				return item.State == "OPEN" || item.Type == "DRAFT_ISSUE", true
+			case "closed":
+				return item.State == "CLOSED" || item.State == "MERGED", true
+			case "merged":
+				return item.State == "MERGED", true
+			case "issue":
+				return item.Type == "ISSUE", true
+			case "pr":
+				return item.Type == "PULL_REQUEST", true
+			case "draft":
+				return item.Type == "DRAFT_ISSUE", true
+			}
+			return false, false
+		})
+	case "assignee":
+		return matchAny(func(value string) (bool, bool) {
+			if strings.HasPrefix(value, "@") {
+				return false, false
+			}
+			for _, assignee := range item.Assignees {
+				if strings.EqualFold(assignee, value) {
+					return true, true
+				}
+			}
+			return false, true
+		})
+	case "repo":
+		return matchAny(func(value string) (bool, bool) {
+			return strings.EqualFold(item.Repository, value), true
+		})
+	case "title":
+		return matchAny(func(value string) (bool, bool) {
+			return strings.Contains(strings.ToLower(item.Title), strings.ToLower(value)), true
+		})
+	case "no", "has":
+		matched, supported := matchAny(func(value string) (bool, bool) {
+			if strings.EqualFold(value, "assignee") {
+				return len(item.Assignees) > 0, true
+			}
+			if unsupportedViewQualifiers[strings.ToLower(value)] {
+				return false, false
+			}
+			v, ok := item.fieldValue(value)
+			return ok && v.hasValue(), true
+		})
+		if qualifier == "no" {
+			return !matched, supported
+		}
+		return matched, supported
+	}
+
+	if unsupportedViewQualifiers[qualifier] {
+		return false, false
+	}
+
+	// UNDERSTANDING: Anything else is a custom field name; field names with spaces may be written with hyphens
+	return matchAny(func(value string) (bool, bool) {
+		if strings.ContainsAny(value, "@<>*") || strings.Contains(value, "..") {
+			return false, false
+		}
+		v, ok := item.fieldValue(qualifier)
+		if !ok {
+			v, ok = item.fieldValue(strings.ReplaceAll(qualifier, "-", " "))
+		}
+		return ok && strings.EqualFold(fmt.Sprint(v.Value), value), true
+	})
+}
+
+// evaluateViewFilter checks an item against a view filter. Terms are ANDed and comma-separated values are ORed.
+// It returns whether every supported term matched and the terms that could not be evaluated.
+func evaluateViewFilter(item projectItem, filter string) (bool, []string) {
+	var unsupported []string
+	for _, term := range splitViewFilter(filter) {
+		negated := strings.HasPrefix(term, "-")
+		body := strings.TrimPrefix(term, "-")
+
+		var matched, supported bool
+		qualifier, value, hasQualifier := strings.Cut(body, ":")
+		if hasQualifier {
+			matched, supported = matchViewFilterTerm(item, strings.ToLower(qualifier), strings.Split(value, ","))
+		} else {
+			// Free text matches against the title
+			matched, supported = strings.Contains(strings.ToLower(item.Title), strings.ToLower(body)), true
+		}
+
+		if !supported {
+			unsupported = append(unsupported, term)
+			continue
+		}
+		if matched == negated {
+			return false, unsupported
+		}
+	}
+	return true, unsupported
+}
+
+// UNDERSTANDING: Work out which saved views of a project show a given item
+// EXPECTS: project_id and item_id (project item node ID)
+// RETURNS: Views that show the item, views that hide it, and views whose filter could not be fully evaluated
+// INTEGRATION: Filters are evaluated locally against the item's content and field values; only a subset of
+// the filter syntax is supported, so views using other qualifiers are reported as undetermined
+func GetProjectItemVisibleViews(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_item_visible_views",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ITEM_VISIBLE_VIEWS_DESCRIPTION", "Determine which views of a GitHub Projects v2 board show a given item by evaluating each view's filter against the item. Supports free text, is:, assignee:, repo:, title:, no:, has: and custom field qualifiers (with - negation and comma-separated values). Views using other qualifiers (e.g., label:, @me, @current, date ranges) are reported as undetermined.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ITEM_VISIBLE_VIEWS_USER_TITLE", "Get views showing a project item"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				ItemID    string `mapstructure:"item_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			views, err := fetchProjectViews(ctx, client, params.ProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project views", err), nil
+			}
+
+			item, err := fetchProjectItem(ctx, client, params.ItemID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+			}
+
+			visible := []projectView{}
+			hidden := []projectView{}
+			undetermined := []map[string]interface{}{}
+			for _, view := range views {
+				matched, unsupported := evaluateViewFilter(item, string(view.Filter))
+				switch {
+				case !matched:
+					hidden = append(hidden, view)
+				case len(unsupported) > 0:
+					undetermined = append(undetermined, map[string]interface{}{
+						"id":                view.ID,
+						"name":              view.Name,
+						"number":            view.Number,
+						"layout":            view.Layout,
+						"filter":            view.Filter,
+						"unsupported_terms": unsupported,
+					})
+				default:
+					visible = append(visible, view)
+				}
+			}
+
+			response := map[string]interface{}{
+				"project_id":         params.ProjectID,
+				"item_id":            params.ItemID,
+				"visible_views":      visible,
+				"hidden_views":       hidden,
+				"undetermined_views": undetermined,
+			}
+			if len(undetermined) > 0 {
+				response["note"] = "Some view filters use qualifiers that cannot be evaluated here; the item matches their supported terms but may still be hidden"
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// projectFieldsQuery lists a page of a project's fields with the details needed to resolve them by name.
+type projectFieldsQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Fields struct {
+				Nodes    []projectFieldNode
+				PageInfo struct {
+					HasNextPage githubv4.Boolean
+					EndCursor   githubv4.String
+				}
+			} `graphql:"fields(first: 100, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+// projectFieldNode is a single field as selected by projectFieldsQuery.
+type projectFieldNode struct {
+	Common struct {
+		ID       githubv4.ID
+		Name     githubv4.String
+		DataType githubv4.String
+	} `graphql:"... on ProjectV2FieldCommon"`
+	SingleSelectField struct {
+		Options []projectSingleSelectOption
+	} `graphql:"... on ProjectV2SingleSelectField"`
+	IterationField struct {
+		Configuration projectIterationConfiguration
+	} `graphql:"... on ProjectV2IterationField"`
+}
+
+// projectField is the normalized view of a project field.
+type projectField struct {
+	ID         string                      `json:"id"`
+	Name       string                      `json:"name"`
+	DataType   string                      `json:"data_type"`
+	Options    []projectSingleSelectOption `json:"options,omitempty"`
+	Iterations []projectIterationNode      `json:"iterations,omitempty"`
+}
+
+// newProjectField flattens a projectFieldNode into a projectField.
+func newProjectField(node projectFieldNode) projectField {
+	return projectField{
+		ID:         fmt.Sprint(node.Common.ID),
+		Name:       string(node.Common.Name),
+		DataType:   string(node.Common.DataType),
+		Options:    node.SingleSelectField.Options,
+		Iterations: node.IterationField.Configuration.Iterations,
+	}
+}
+
+// fetchProjectFields returns every field configured on a project, following pagination.
+func fetchProjectFields(ctx context.Context, client *githubv4.Client, projectID string) ([]projectField, error) {
+	var fields []projectField
+	var after *githubv4.String
+	for {
+		var query projectFieldsQuery
+		if err := client.Query(ctx, &query, map[string]interface{}{
+			"projectId": githubv4.ID(projectID),
+			"after":     after,
+		}); err != nil {
+			return nil, err
+		}
+
+		for _, node := range query.Node.ProjectV2.Fields.Nodes {
+			fields = append(fields, newProjectField(node))
+		}
+
+		pageInfo := query.Node.ProjectV2.Fields.PageInfo
+		if !pageInfo.HasNextPage {
+			return fields, nil
+		}
+		cursor := pageInfo.EndCursor
+		after = &cursor
+	}
+}
+
+// projectFieldQuery looks up a single project field by node ID.
+type projectFieldQuery struct {
+	Node projectFieldNode `graphql:"node(id: $id)"`
+}
+
+// fetchProjectField looks up a single project field by node ID.
+func fetchProjectField(ctx context.Context, client *githubv4.Client, fieldID string) (projectField, error) {
+	var query projectFieldQuery
+	if err := client.Query(ctx, &query, map[string]interface{}{
+		"id": githubv4.ID(fieldID),
+	}); err != nil {
+		return projectField{}, err
+	}
+	if query.Node.Common.ID == nil {
+		return projectField{}, fmt.Errorf("field %s not found or is not a project field", fieldID)
+	}
+	return newProjectField(query.Node), nil
+}
+
+// findProjectField looks up a field by name, case-insensitively.
+func findProjectField(fields []projectField, name string) (projectField, bool) {
+	for _, field := range fields {
+		if strings.EqualFold(field.Name, name) {
+			return field, true
+		}
+	}
+	return projectField{}, false
+}
+
+// fetchProjectStatusField looks up a project's single-select status field by name.
+// UNDERSTANDING: The field's options are returned in the order defined on the board, i.e. the workflow order
+func fetchProjectStatusField(ctx context.Context, client *githubv4.Client, projectID, fieldName string) (projectField, error) {
+	fields, err := fetchProjectFields(ctx, client, projectID)
+	if err != nil {
+		return projectField{}, fmt.Errorf("failed to get project fields: %w", err)
+	}
+	field, ok := findProjectField(fields, fieldName)
+	if !ok {
+		return projectField{}, fmt.Errorf("field %q not found in project", fieldName)
+	}
+	if field.DataType != "SINGLE_SELECT" {
+		return projectField{}, fmt.Errorf("field %q is a %s field, not a single-select field", field.Name, field.DataType)
+	}
+	return field, nil
+}
+
+// resolveProjectStatusField looks up a single-select field by ID, or by name (default "Status") when no ID is given.
+func resolveProjectStatusField(ctx context.Context, client *githubv4.Client, projectID, fieldID, fieldName string) (projectField, error) {
+	if fieldID == "" {
+		if fieldName == "" {
+			fieldName = "Status"
+		}
+		return fetchProjectStatusField(ctx, client, projectID, fieldName)
+	}
+
+	field, err := fetchProjectField(ctx, client, fieldID)
+	if err != nil {
+		return projectField{}, fmt.Errorf("failed to get status field: %w", err)
+	}
+	if field.DataType != "SINGLE_SELECT" {
+		return projectField{}, fmt.Errorf("field %q is a %s field, not a single-select field", field.Name, field.DataType)
+	}
+	return field, nil
+}
+
+// UNDERSTANDING: Set the same text field value on many items at once (e.g., Quarter = "Q3")
+// EXPECTS: project_id, field_name (text field), text, item_ids
+// RETURNS: The items that were updated and any that failed with their error
+// INTEGRATION: Resolves the field once by name, then updates each item; one failure does not stop the rest
+func SetProjectItemsTextField(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_project_items_text_field",
+			mcp.WithDescription(t("TOOL_SET_PROJECT_ITEMS_TEXT_FIELD_DESCRIPTION", "Set a text field to the same value on several GitHub Projects v2 items at once, resolving the field by name. Use this to batch-annotate items (e.g., set Quarter to Q3).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_PROJECT_ITEMS_TEXT_FIELD_USER_TITLE", "Set text field on project items"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("field_name",
+				mcp.Required(),
+				mcp.Description("Name of the text field to set (case-insensitive)"),
+			),
+			mcp.WithString("text",
+				mcp.Required(),
+				mcp.Description("Text value to set on every item"),
+			),
+			mcp.WithArray("item_ids",
+				mcp.Required(),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+				mcp.Description("Project item IDs (PVTI_xxxx format) to update"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string   `mapstructure:"project_id"`
+				FieldName string   `mapstructure:"field_name"`
+				Text      string   `mapstructure:"text"`
+				ItemIDs   []string `mapstructure:"item_ids"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.ItemIDs) == 0 {
+				return mcp.NewToolResultError("item_ids must contain at least one item ID"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fields, err := fetchProjectFields(ctx, client, params.ProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+			}
+			field, ok := findProjectField(fields, params.FieldName)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("field %q not found in project", params.FieldName)), nil
+			}
+			if field.DataType != "TEXT" {
+				return mcp.NewToolResultError(fmt.Sprintf("field %q is a %s field, not a text field", field.Name, field.DataType)), nil
+			}
+
+			var updateFieldMutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+
+			updated := []string{}
+			failed := []map[string]interface{}{}
+			for _, itemID := range params.ItemIDs {
+				if err := client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+					ProjectID: githubv4.ID(params.ProjectID),
+					ItemID:    githubv4.ID(itemID),
+					FieldID:   githubv4.ID(field.ID),
+					Value: githubv4.ProjectV2FieldValue{
+						Text: githubv4.NewString(githubv4.String(params.Text)),
+					},
+				}, nil); err != nil {
+					failed = append(failed, map[string]interface{}{
+						"item_id": itemID,
+						"error":   err.Error(),
+					})
+					continue
+				}
+				updated = append(updated, itemID)
+			}
+
+			response := map[string]interface{}{
+				"success":    len(failed) == 0,
+				"message":    fmt.Sprintf("Set %s on %d of %d item(s)", field.Name, len(updated), len(params.ItemIDs)),
+				"field_id":   field.ID,
+				"field_name": field.Name,
+				"updated":    updated,
+				"failed":     failed,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// projectContentItems lists a page of the project items that reference an issue or pull request.
+type projectContentItems struct {
+	Nodes []struct {
+		ID      githubv4.ID
+		Project struct {
+			ID githubv4.ID
+		}
+	}
+	PageInfo struct {
+		HasNextPage githubv4.Boolean
+		EndCursor   githubv4.String
+	}
+}
+
+// projectContentQuery resolves an issue or pull request number to its node ID and project items.
+type projectContentQuery struct {
+	Repository struct {
+		IssueOrPullRequest struct {
+			Issue struct {
+				ID           githubv4.ID
+				ProjectItems projectContentItems `graphql:"projectItems(first: 100, after: $itemsAfter)"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				ID           githubv4.ID
+				ProjectItems projectContentItems `graphql:"projectItems(first: 100, after: $itemsAfter)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"issueOrPullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// projectContentRef is an issue or pull request resolved from its URL.
+type projectContentRef struct {
+	ContentID string
+	// ItemIDs maps project ID to the item ID of this content on that project
+	ItemIDs map[string]string
+}
+
+// resolveProjectContent resolves an issue or pull request URL to its node ID and the project items referencing it.
+// VERIFIED: Looking up the content's projectItems avoids walking the whole board to find an item; they are paged
+// through so content on many projects is never reported as missing from one of them
+func resolveProjectContent(ctx context.Context, client *githubv4.Client, rawURL string) (projectContentRef, error) {
+	owner, repo, kind, number, err := parseProjectContentURL(rawURL)
+	if err != nil {
+		return projectContentRef{}, err
+	}
+	if kind == "discussions" {
+		return projectContentRef{}, fmt.Errorf("%s is a discussion URL; expected an issue or pull request", rawURL)
+	}
+
+	ref := projectContentRef{ItemIDs: map[string]string{}}
+	var itemsAfter *githubv4.String
+	for {
+		var query projectContentQuery
+		if err := client.Query(ctx, &query, map[string]interface{}{
+			"owner":      githubv4.String(owner),
+			"repo":       githubv4.String(repo),
+			"number":     githubv4.Int(number), // #nosec G115 - issue numbers are always small positive integers
+			"itemsAfter": itemsAfter,
+		}); err != nil {
+			return projectContentRef{}, err
+		}
+
+		content := query.Repository.IssueOrPullRequest
+		id, items := content.Issue.ID, content.Issue.ProjectItems
+		if id == nil {
+			id, items = content.PullRequest.ID, content.PullRequest.ProjectItems
+		}
+		if id == nil {
+			return projectContentRef{}, fmt.Errorf("%s/%s#%d not found", owner, repo, number)
+		}
+
+		ref.ContentID = fmt.Sprint(id)
+		for _, node := range items.Nodes {
+			ref.ItemIDs[fmt.Sprint(node.Project.ID)] = fmt.Sprint(node.ID)
+		}
+		if !items.PageInfo.HasNextPage {
+			return ref, nil
+		}
+		itemsAfter = githubv4.NewString(items.PageInfo.EndCursor)
+	}
+}
+
+// UNDERSTANDING: Resolve an issue or pull request URL to both its content node ID and its item ID on a project
+// EXPECTS: project_id, issue_url (issue or pull request URL)
+// RETURNS: content_id always; item_id when the content is on the board, otherwise on_board false
+// INTEGRATION: Saves agents separate lookups before calling item tools (item_id) or add_item_to_project (content_id)
+func GetProjectItemAndContentIDs(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_item_and_content_ids",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ITEM_AND_CONTENT_IDS_DESCRIPTION", "Resolve an issue or pull request URL to its content node ID and, if it is on the given GitHub Projects v2 board, its project item ID. Returns on_board false when the content is not on the board.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ITEM_AND_CONTENT_IDS_USER_TITLE", "Get project item and content IDs"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("issue_url",
+				mcp.Required(),
+				mcp.Description("Issue or pull request URL (e.g., https://github.com/owner/repo/issues/123)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				IssueURL  string `mapstructure:"issue_url"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			content, err := resolveProjectContent(ctx, client, params.IssueURL)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to resolve content", err), nil
+			}
+
+			response := map[string]interface{}{
+				"project_id": params.ProjectID,
+				"issue_url":  params.IssueURL,
+				"content_id": content.ContentID,
+			}
+			if itemID, ok := content.ItemIDs[params.ProjectID]; ok {
+				response["on_board"] = true
+				response["item_id"] = itemID
+			} else {
+				response["on_board"] = false
+				response["message"] = "Content is not on this project board; add it with add_item_to_project"
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// normalizeOptionName folds case and drops whitespace, hyphens and underscores so "in progress" matches "In-Progress".
+func normalizeOptionName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '_' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
+
+// resolveSingleSelectOption finds the option matching name, preferring an exact match and falling back to a
+// normalized match. It errors when nothing matches or when the normalized name matches several options.
+func resolveSingleSelectOption(field projectField, name string) (projectSingleSelectOption, error) {
+	var candidates []projectSingleSelectOption
+	wanted := normalizeOptionName(name)
+	for _, option := range field.Options {
+		if string(option.Name) == name {
+			return option, nil
+		}
+		if normalizeOptionName(string(option.Name)) == wanted {
+			candidates = append(candidates, option)
+		}
+	}
+
+	names := func(options []projectSingleSelectOption) string {
+		quoted := make([]string, 0, len(options))
+		for _, option := range options {
+			quoted = append(quoted, fmt.Sprintf("%q", option.Name))
+		}
+		return strings.Join(quoted, ", ")
+	}
+
+	switch len(candidates) {
+	case 1:
+		return candidates[0], nil
+	case 0:
+		return projectSingleSelectOption{}, fmt.Errorf("option %q not found in field %q; available options: %s", name, field.Name, names(field.Options))
+	default:
+		return projectSingleSelectOption{}, fmt.Errorf("option %q is ambiguous in field %q; it matches %s", name, field.Name, names(candidates))
+	}
+}
+
+// resolveSingleSelectByName finds a single-select field by name and the option within it, without any API call.
+func resolveSingleSelectByName(fields []projectField, fieldName, optionName string) (projectField, projectSingleSelectOption, error) {
+	field, ok := findProjectField(fields, fieldName)
+	if !ok {
+		return projectField{}, projectSingleSelectOption{}, fmt.Errorf("field %q not found in project", fieldName)
+	}
+	if field.DataType != "SINGLE_SELECT" {
+		return projectField{}, projectSingleSelectOption{}, fmt.Errorf("field %q is a %s field, not a single-select field", field.Name, field.DataType)
+	}
+	option, err := resolveSingleSelectOption(field, optionName)
+	if err != nil {
+		return projectField{}, projectSingleSelectOption{}, err
+	}
+	return field, option, nil
+}
+
+// UNDERSTANDING: Set a single-select field (e.g., Status) on an item by field and option name
+// EXPECTS: project_id, item_id, field_name, option_name (matched ignoring case, spaces, hyphens and underscores)
+// RETURNS: The canonical option name that was set along with its option ID
+// INTEGRATION: Name-based alternative to update_project_item_status, which needs field and option IDs
+func SetProjectItemSingleSelect(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_project_item_single_select",
+			mcp.WithDescription(t("TOOL_SET_PROJECT_ITEM_SINGLE_SELECT_DESCRIPTION", "Set a single-select field (such as Status or Priority) on a GitHub Projects v2 item using the field and option names. Option names are matched ignoring case, whitespace, hyphens and underscores (\"inprogress\" matches \"In Progress\"); the canonical option name is returned. Fails if the name matches more than one option.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_PROJECT_ITEM_SINGLE_SELECT_USER_TITLE", "Set project item single-select field"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format)"),
+			),
+			mcp.WithString("field_name",
+				mcp.Required(),
+				mcp.Description("Name of the single-select field (e.g., 'Status')"),
+			),
+			mcp.WithString("option_name",
+				mcp.Required(),
+				mcp.Description("Name of the option to select (e.g., 'In Progress')"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID  string `mapstructure:"project_id"`
+				ItemID     string `mapstructure:"item_id"`
+				FieldName  string `mapstructure:"field_name"`
+				OptionName string `mapstructure:"option_name"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// UNDERSTANDING: Names are validated against cached field definitions so a valid update needs no extra query
+			// and an invalid one never reaches the mutation. A cached miss is re-checked against fresh fields in case
+			// the option was added since the cache was filled
+			fields, cached, err := fetchCachedProjectFields(ctx, client, params.ProjectID, false)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+			}
+			field, option, err := resolveSingleSelectByName(fields, params.FieldName, params.OptionName)
+			if err != nil && cached {
+				if fields, _, err = fetchCachedProjectFields(ctx, client, params.ProjectID, true); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+				}
+				field, option, err = resolveSingleSelectByName(fields, params.FieldName, params.OptionName)
+			}
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var updateFieldMutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				ItemID:    githubv4.ID(params.ItemID),
+				FieldID:   githubv4.ID(field.ID),
+				Value: githubv4.ProjectV2FieldValue{
+					SingleSelectOptionID: githubv4.NewString(option.ID),
+				},
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to update project item field", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":     true,
+				"message":     fmt.Sprintf("%s set to %s", field.Name, option.Name),
+				"item_id":     updateFieldMutation.UpdateProjectV2ItemFieldValue.ProjectV2Item.ID,
+				"field_id":    field.ID,
+				"field_name":  field.Name,
+				"option_id":   option.ID,
+				"option_name": option.Name,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: List board items by when they were added, for cohort analysis
+// EXPECTS: project_id, optional max_items
+// RETURNS: Items sorted oldest-first by added_at (the item's createdAt, i.e. when it was added to the project)
+// INTEGRATION: Item createdAt is the add-to-project time, unlike the issue's own creation date. A board truncated at
+// max_items is sorted only among the items fetched in board order, so the response flags the ordering as partial
+func ListProjectItemsWithAddedDate(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_items_with_added_date",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_ITEMS_WITH_ADDED_DATE_DESCRIPTION", "List the items on a GitHub Projects v2 board with the date each was added to the project, sorted oldest-first. Useful for cohort analysis. If the board has more than max_items items, only the first max_items in board order are sorted, so the result is flagged as a partial ordering rather than the oldest items on the board.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_ITEMS_WITH_ADDED_DATE_USER_TITLE", "List project items by added date"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			// VERIFIED: A stable sort keeps board order for items added at the same instant
+			items := append([]projectItem{}, fetched.Items...)
+			sort.SliceStable(items, func(i, j int) bool {
+				return items[i].CreatedAt.Before(items[j].CreatedAt)
+			})
+
+			output := make([]map[string]interface{}, 0, len(items))
+			for _, item := range items {
+				entry := map[string]interface{}{
+					"id":       item.ID,
+					"added_at": item.CreatedAt,
+					"position": item.Position,
+				}
+				if item.Orphaned {
+					entry["orphaned"] = true
+				} else {
+					entry["type"] = item.Type
+					entry["title"] = item.Title
+					entry["url"] = item.URL
+				}
+				output = append(output, entry)
+			}
+
+			response := map[string]interface{}{
+				"project_id":  params.ProjectID,
+				"total_count": len(items),
+				"items":       output,
+				"ordering":    "complete",
+			}
+			fetched.addTruncation(response)
+			if fetched.Truncated {
+				response["ordering"] = "partial"
+				response["ordering_note"] = fmt.Sprintf("Only the first %d items in board order were fetched and sorted, so these are not necessarily the oldest items on the board; raise max_items above the board's item count for a complete ordering", len(items))
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// coercedFieldValue is a raw string value interpreted for a specific field type.
+type coercedFieldValue struct {
+	Input       githubv4.ProjectV2FieldValue
+	Value       interface{}
+	OptionID    string
+	IterationID string
+	// Report describes the interpretation, e.g. "'3' → number 3.0 for field Estimate"
+	Report string
+}
+
+// coerceProjectFieldValue interprets a raw string according to the field's data type.
+// UNDERSTANDING: Numbers and dates are parsed, single-select values may be an option ID or a (fuzzy) option name,
+// iteration values may be an iteration ID, an iteration title, current or next (with or without a leading @)
+func coerceProjectFieldValue(field projectField, raw string) (coercedFieldValue, error) {
+	switch field.DataType {
+	case "TEXT":
+		return coercedFieldValue{
+			Input:  githubv4.ProjectV2FieldValue{Text: githubv4.NewString(githubv4.String(raw))},
+			Value:  raw,
+			Report: fmt.Sprintf("'%s' → text %q for field %s", raw, raw, field.Name),
+		}, nil
+	case "NUMBER":
+		number, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		if err != nil {
+			return coercedFieldValue{}, fmt.Errorf("value %q is not a number, but field %q is a NUMBER field", raw, field.Name)
+		}
+		formatted := strconv.FormatFloat(number, 'f', -1, 64)
+		if !strings.Contains(formatted, ".") {
+			formatted += ".0"
+		}
+		return coercedFieldValue{
+			Input:  githubv4.ProjectV2FieldValue{Number: githubv4.NewFloat(githubv4.Float(number))},
+			Value:  number,
+			Report: fmt.Sprintf("'%s' → number %s for field %s", raw, formatted, field.Name),
+		}, nil
+	case "DATE":
+		date, err := time.Parse("2006-01-02", strings.TrimSpace(raw))
+		if err != nil {
+			return coercedFieldValue{}, fmt.Errorf("value %q is not a YYYY-MM-DD date, but field %q is a DATE field", raw, field.Name)
+		}
+		return coercedFieldValue{
+			Input:  githubv4.ProjectV2FieldValue{Date: &githubv4.Date{Time: date}},
+			Value:  date.Format("2006-01-02"),
+			Report: fmt.Sprintf("'%s' → date %s for field %s", raw, date.Format("2006-01-02"), field.Name),
+		}, nil
+	case "SINGLE_SELECT":
+		option, found := projectSingleSelectOption{}, false
+		for _, candidate := range field.Options {
+			if string(candidate.ID) == raw {
+				option, found = candidate, true
+				break
+			}
+		}
+		if !found {
+			var err error
+			if option, err = resolveSingleSelectOption(field, raw); err != nil {
+				return coercedFieldValue{}, err
+			}
+		}
+		return coercedFieldValue{
+			Input:    githubv4.ProjectV2FieldValue{SingleSelectOptionID: githubv4.NewString(option.ID)},
+			Value:    string(option.Name),
+			OptionID: string(option.ID),
+			Report:   fmt.Sprintf("'%s' → option %q (%s) for field %s", raw, option.Name, option.ID, field.Name),
+		}, nil
+	case "ITERATION":
+		iteration, err := resolveProjectIteration(field, raw, time.Now())
+		if err != nil {
+			return coercedFieldValue{}, err
+		}
+		return coercedFieldValue{
+			Input:       githubv4.ProjectV2FieldValue{IterationID: githubv4.NewString(iteration.ID)},
+			Value:       string(iteration.Title),
+			IterationID: string(iteration.ID),
+			Report:      fmt.Sprintf("'%s' → iteration %q (%s, starts %s) for field %s", raw, iteration.Title, iteration.ID, iteration.StartDate, field.Name),
+		}, nil
+	default:
+		return coercedFieldValue{}, fmt.Errorf("field %q has type %s, which cannot be set from a single value", field.Name, field.DataType)
+	}
+}
+
+// resolveProjectIteration finds an iteration of an iteration field by ID or title (ignoring case). The
+// keywords current and next (optionally written @current and @next) pick the iteration running at now and the
+// first one starting after it. A bare keyword only applies when no iteration has that title.
+// UNDERSTANDING: Only active and upcoming iterations are configured on the field; completed ones cannot be set
+func resolveProjectIteration(field projectField, raw string, now time.Time) (projectIterationNode, error) {
+	value := strings.TrimSpace(raw)
+	for _, iteration := range field.Iterations {
+		if string(iteration.ID) == value {
+			return iteration, nil
+		}
+	}
+
+	keyword := strings.ToLower(value)
+	if !strings.HasPrefix(keyword, "@") {
+		for _, iteration := range field.Iterations {
+			if strings.EqualFold(string(iteration.Title), value) {
+				return iteration, nil
+			}
+		}
+	}
+	keyword = strings.TrimPrefix(keyword, "@")
+	if keyword == "current" || keyword == "next" {
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		var next *projectIterationNode
+		for i, iteration := range field.Iterations {
+			start, err := time.Parse("2006-01-02", string(iteration.StartDate))
+			if err != nil {
+				continue
+			}
+			end := start.AddDate(0, 0, int(iteration.Duration))
+			if keyword == "current" && !today.Before(start) && today.Before(end) {
+				return iteration, nil
+			}
+			if keyword == "next" && start.After(today) && (next == nil || string(iteration.StartDate) < string(next.StartDate)) {
+				next = &field.Iterations[i]
+			}
+		}
+		if next != nil {
+			return *next, nil
+		}
+		return projectIterationNode{}, fmt.Errorf("field %q has no %s iteration", field.Name, keyword)
+	}
+
+	titles := make([]string, 0, len(field.Iterations))
+	for _, iteration := range field.Iterations {
+		titles = append(titles, fmt.Sprintf("%q", iteration.Title))
+	}
+	return projectIterationNode{}, fmt.Errorf("iteration %q not found in field %q; available iterations: %s", raw, field.Name, strings.Join(titles, ", "))
+}
+
+// customFieldTypes are the field data types users can create, and therefore delete.
+var customFieldTypes = map[string]bool{
+	"TEXT":          true,
+	"NUMBER":        true,
+	"DATE":          true,
+	"SINGLE_SELECT": true,
+	"ITERATION":     true,
+}
+
+// matchFieldNamePattern matches a field name against a glob pattern, or as a prefix when the pattern has no
+// glob characters. Matching is case-insensitive.
+func matchFieldNamePattern(pattern, name string) (bool, error) {
+	pattern, name = strings.ToLower(pattern), strings.ToLower(name)
+	if !strings.ContainsAny(pattern, "*?[") {
+		return strings.HasPrefix(name, pattern), nil
+	}
+	return path.Match(pattern, name)
+}
+
+// UNDERSTANDING: Remove stray custom fields left over from experiments in one call
+// EXPECTS: project_id, name_pattern (glob like "tmp-*" or a plain prefix), confirm
+// RETURNS: Matching fields, and the IDs/names of those deleted when confirm is true
+// INTEGRATION: Built-in fields (Title, Assignees, Labels, ...) are never matched; without confirm this is a preview
+func DeleteProjectFieldsByPattern(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_project_fields_by_pattern",
+			mcp.WithDescription(t("TOOL_DELETE_PROJECT_FIELDS_BY_PATTERN_DESCRIPTION", "Delete every custom field on a GitHub Projects v2 board whose name matches a glob pattern (e.g., 'tmp-*') or prefix. Built-in fields are never deleted. Nothing is deleted unless confirm is true; without it the matching fields are only listed. Deleting a field permanently removes its values from all items.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_PROJECT_FIELDS_BY_PATTERN_USER_TITLE", "Delete project fields by pattern"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("name_pattern",
+				mcp.Required(),
+				mcp.Description("Glob pattern (*, ?, [...]) or plain prefix to match field names against, case-insensitive"),
+			),
+			mcp.WithBoolean("confirm",
+				mcp.Description("Must be true to actually delete the matching fields; otherwise they are only listed"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID   string `mapstructure:"project_id"`
+				NamePattern string `mapstructure:"name_pattern"`
+				Confirm     bool   `mapstructure:"confirm"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if strings.TrimSpace(params.NamePattern) == "" {
+				return mcp.NewToolResultError("name_pattern must not be empty"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fields, err := fetchProjectFields(ctx, client, params.ProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+			}
+
+			matched := []map[string]interface{}{}
+			var toDelete []projectField
+			for _, field := range fields {
+				if !customFieldTypes[field.DataType] {
+					continue
+				}
+				ok, err := matchFieldNamePattern(params.NamePattern, field.Name)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid name_pattern %q: %v", params.NamePattern, err)), nil
+				}
+				if !ok {
+					continue
+				}
+				toDelete = append(toDelete, field)
+				matched = append(matched, map[string]interface{}{
+					"id":        field.ID,
+					"name":      field.Name,
+					"data_type": field.DataType,
+				})
+			}
+
+			response := map[string]interface{}{
+				"project_id":   params.ProjectID,
+				"name_pattern": params.NamePattern,
+				"confirmed":    params.Confirm,
+				"matched":      matched,
+			}
+
+			// VERIFIED: Without confirm the tool only previews, so an over-broad pattern cannot destroy data
+			if !params.Confirm {
+				response["message"] = fmt.Sprintf("%d field(s) match; re-run with confirm: true to delete them", len(toDelete))
+			} else {
+				var deleteFieldMutation struct {
+					DeleteProjectV2Field struct {
+						ProjectV2Field struct {
+							Common struct {
+								ID githubv4.ID
+							} `graphql:"... on ProjectV2FieldCommon"`
+						}
+					} `graphql:"deleteProjectV2Field(input: $input)"`
+				}
+
+				deleted := []map[string]interface{}{}
+				failed := []map[string]interface{}{}
+				for _, field := range toDelete {
+					if err := client.Mutate(ctx, &deleteFieldMutation, githubv4.DeleteProjectV2FieldInput{
+						FieldID: githubv4.ID(field.ID),
+					}, nil); err != nil {
+						failed = append(failed, map[string]interface{}{
+							"id":    field.ID,
+							"name":  field.Name,
+							"error": err.Error(),
+						})
+						continue
+					}
+					deleted = append(deleted, map[string]interface{}{
+						"id":   field.ID,
+						"name": field.Name,
+					})
+				}
+				response["success"] = len(failed) == 0
+				response["deleted"] = deleted
+				response["failed"] = failed
+				response["message"] = fmt.Sprintf("Deleted %d of %d matching field(s)", len(deleted), len(toDelete))
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// ownerProjectsConnection is the projectsV2 connection shared by users and organizations.
+type ownerProjectsConnection struct {
+	Nodes []struct {
+		ID        githubv4.ID       `json:"id"`
+		Number    githubv4.Int      `json:"number"`
+		Title     githubv4.String   `json:"title"`
+		URL       githubv4.String   `json:"url"`
+		Closed    githubv4.Boolean  `json:"closed"`
+		CreatedAt githubv4.DateTime `json:"created_at"`
+		UpdatedAt githubv4.DateTime `json:"updated_at"`
+	}
+	TotalCount githubv4.Int
+	PageInfo   struct {
+		HasNextPage githubv4.Boolean
+		EndCursor   githubv4.String
+	}
+}
+
+// sortNodes orders the page's projects by "updated", "created" or "title". Without a direction, dates sort
+// newest first and titles alphabetically (case-insensitively).
+func (c *ownerProjectsConnection) sortNodes(by, direction string) {
+	descending := direction == "desc" || (direction == "" && by != "title")
+	sort.SliceStable(c.Nodes, func(i, j int) bool {
+		a, b := c.Nodes[i], c.Nodes[j]
+		if descending {
+			a, b = b, a
+		}
+		switch by {
+		case "created":
+			return a.CreatedAt.Before(b.CreatedAt.Time)
+		case "title":
+			return strings.ToLower(string(a.Title)) < strings.ToLower(string(b.Title))
+		default:
+			return a.UpdatedAt.Before(b.UpdatedAt.Time)
+		}
+	})
+}
+
+// fetchOwnerProjects lists a page of a user's or organization's projects, starting after the given cursor.
+func fetchOwnerProjects(ctx context.Context, client *githubv4.Client, login, ownerType string, first int, after *githubv4.String) (ownerProjectsConnection, error) {
+	variables := map[string]interface{}{
+		"login": githubv4.String(login),
+		"first": githubv4.Int(first), // #nosec G115 - first is capped at 100
+		"after": after,
+	}
+
+	switch ownerType {
+	case "user":
+		var query struct {
+			User *struct {
+				ProjectsV2 ownerProjectsConnection `graphql:"projectsV2(first: $first, after: $after)"`
+			} `graphql:"user(login: $login)"`
+		}
+		if err := client.Query(ctx, &query, variables); err != nil {
+			return ownerProjectsConnection{}, err
+		}
+		if query.User == nil {
+			return ownerProjectsConnection{}, fmt.Errorf("could not resolve to a user with the login %q", login)
+		}
+		return query.User.ProjectsV2, nil
+	case "organization":
+		var query struct {
+			Organization *struct {
+				ProjectsV2 ownerProjectsConnection `graphql:"projectsV2(first: $first, after: $after)"`
+			} `graphql:"organization(login: $login)"`
+		}
+		if err := client.Query(ctx, &query, variables); err != nil {
+			return ownerProjectsConnection{}, err
+		}
+		if query.Organization == nil {
+			return ownerProjectsConnection{}, fmt.Errorf("could not resolve to an organization with the login %q", login)
+		}
+		return query.Organization.ProjectsV2, nil
+	default:
+		return ownerProjectsConnection{}, fmt.Errorf("unknown owner type %q: expected user or organization", ownerType)
+	}
+}
+
+// UNDERSTANDING: List projects across several users and organizations in one call
+// EXPECTS: owners [{login, type (user|organization), optional first}], optional first (per owner, default 10)
+// RETURNS: One section per owner plus combined totals
+// INTEGRATION: A failing owner is reported in its section without hiding the others
+func ListProjectsForOwners(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_projects_for_owners",
+			mcp.WithDescription(t("TOOL_LIST_PROJECTS_FOR_OWNERS_DESCRIPTION", "List GitHub Projects v2 boards for several users and organizations at once. Returns a section per owner with its projects and total count, plus combined totals across all owners.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECTS_FOR_OWNERS_USER_TITLE", "List projects for owners"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithArray("owners",
+				mcp.Required(),
+				mcp.Items(
+					map[string]interface{}{
+						"type":                 "object",
+						"additionalProperties": false,
+						"required":             []string{"login", "type"},
+						"properties": map[string]interface{}{
+							"login": map[string]interface{}{
+								"type":        "string",
+								"description": "User or organization login",
+							},
+							"type": map[string]interface{}{
+								"type":        "string",
+								"enum":        []string{"user", "organization"},
+								"description": "Owner type",
+							},
+							"first": map[string]interface{}{
+								"type":        "number",
+								"description": "Number of projects to retrieve for this owner (overrides the top-level first)",
+							},
+						},
+					}),
+				mcp.Description("Owners to list projects for, each with login and type"),
+			),
+			mcp.WithNumber("first",
+				mcp.Description("Number of projects to retrieve per owner (default: 10, max: 100)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				Owners []struct {
+					Login string `mapstructure:"login"`
+					Type  string `mapstructure:"type"`
+					First int    `mapstructure:"first"`
+				} `mapstructure:"owners"`
+				First int `mapstructure:"first"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.Owners) == 0 {
+				return mcp.NewToolResultError("owners must contain at least one owner"), nil
+			}
+			if params.First <= 0 {
+				params.First = 10
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			sections := make([]map[string]interface{}, 0, len(params.Owners))
+			totalCount, returnedCount, failedOwners := 0, 0, 0
+			for _, owner := range params.Owners {
+				first := params.First
+				if owner.First > 0 {
+					first = owner.First
+				}
+				first = min(first, 100)
+
+				section := map[string]interface{}{
+					"login": owner.Login,
+					"type":  owner.Type,
+				}
+				projects, err := fetchOwnerProjects(ctx, client, owner.Login, strings.ToLower(owner.Type), first, nil)
+				if err != nil {
+					failedOwners++
+					section["error"] = err.Error()
+					sections = append(sections, section)
+					continue
+				}
+
+				section["total_count"] = int(projects.TotalCount)
+				section["has_next_page"] = bool(projects.PageInfo.HasNextPage)
+				section["end_cursor"] = projects.PageInfo.EndCursor
+				section["projects"] = projects.Nodes
+				sections = append(sections, section)
+				totalCount += int(projects.TotalCount)
+				returnedCount += len(projects.Nodes)
+			}
+
+			response := map[string]interface{}{
+				"owners":         sections,
+				"total_count":    totalCount,
+				"returned_count": returnedCount,
+				"failed_owners":  failedOwners,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Give every item without a status a default one (e.g., unset Status becomes Todo)
+// EXPECTS: project_id, default_option, optional status_field_name (default "Status") and max_items
+// RETURNS: How many items were defaulted and which, plus items that failed
+// INTEGRATION: Items that already have a value are never touched; deleted and inaccessible items are skipped
+func DefaultProjectItemStatus(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("default_project_item_status",
+			mcp.WithDescription(t("TOOL_DEFAULT_PROJECT_ITEM_STATUS_DESCRIPTION", "Set a default single-select option (e.g., Status = Todo) on every GitHub Projects v2 item that currently has no value for the field. Items that already have a value are left unchanged.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DEFAULT_PROJECT_ITEM_STATUS_USER_TITLE", "Default unset project item status"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("default_option",
+				mcp.Required(),
+				mcp.Description("Option name to set on items with no value (e.g., 'Todo')"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Name of the single-select status field (default: 'Status')"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string `mapstructure:"project_id"`
+				DefaultOption   string `mapstructure:"default_option"`
+				StatusFieldName string `mapstructure:"status_field_name"`
+				MaxItems        int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			field, err := fetchProjectStatusField(ctx, client, params.ProjectID, params.StatusFieldName)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			option, err := resolveSingleSelectOption(field, params.DefaultOption)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			var updateFieldMutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+
+			// VERIFIED: REDACTED and orphaned items carry no field values, so they would look unset without this check
+			defaulted := []string{}
+			failed := []map[string]interface{}{}
+			alreadySet := 0
+			for _, item := range fetched.Items {
+				if item.Orphaned || item.Type == "REDACTED" {
+					continue
+				}
+				if item.singleSelectName(field.Name) != "" {
+					alreadySet++
+					continue
+				}
+				if err := client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+					ProjectID: githubv4.ID(params.ProjectID),
+					ItemID:    githubv4.ID(item.ID),
+					FieldID:   githubv4.ID(field.ID),
+					Value: githubv4.ProjectV2FieldValue{
+						SingleSelectOptionID: githubv4.NewString(option.ID),
+					},
+				}, nil); err != nil {
+					failed = append(failed, map[string]interface{}{
+						"item_id": item.ID,
+						"error":   err.Error(),
+					})
+					continue
+				}
+				defaulted = append(defaulted, item.ID)
+			}
+
+			response := map[string]interface{}{
+				"success":         len(failed) == 0,
+				"message":         fmt.Sprintf("Set %s to %s on %d item(s) with no value", field.Name, option.Name, len(defaulted)),
+				"field_name":      field.Name,
+				"option_name":     option.Name,
+				"defaulted_count": len(defaulted),
+				"defaulted":       defaulted,
+				"already_set":     alreadySet,
+				"failed":          failed,
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Fetch a project's short description and README together for a board landing page
+// EXPECTS: project_id
+// RETURNS: title, short_description and readme (empty strings when unset)
+// INTEGRATION: Read counterpart to the description/readme parts of updateProjectV2
+func GetProjectDescription(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_description",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_DESCRIPTION_DESCRIPTION", "Get the short description and README of a GitHub Projects v2 board in one call.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_DESCRIPTION_USER_TITLE", "Get project description"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var projectQuery struct {
+				Node struct {
+					ProjectV2 struct {
+						ID               githubv4.ID
+						Title            githubv4.String
+						ShortDescription githubv4.String
+						Readme           githubv4.String
+					} `graphql:"... on ProjectV2"`
+				} `graphql:"node(id: $id)"`
+			}
+			if err := client.Query(ctx, &projectQuery, map[string]interface{}{
+				"id": githubv4.ID(params.ProjectID),
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project", err), nil
+			}
+			project := projectQuery.Node.ProjectV2
+			if project.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project %s not found", params.ProjectID)), nil
+			}
+
+			response := map[string]interface{}{
+				"project_id":        project.ID,
+				"title":             project.Title,
+				"short_description": project.ShortDescription,
+				"readme":            project.Readme,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Find board items nobody has touched in a while
+// EXPECTS: project_id, days (staleness threshold), optional max_items
+// RETURNS: Items whose updatedAt is older than the threshold, oldest first, with days since last update
+// INTEGRATION: Uses the project item's updatedAt, which changes when its field values change on the board
+func FindStaleProjectItems(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("find_stale_project_items",
+			mcp.WithDescription(t("TOOL_FIND_STALE_PROJECT_ITEMS_DESCRIPTION", "Find items on a GitHub Projects v2 board that have not been updated in the given number of days, sorted oldest first.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FIND_STALE_PROJECT_ITEMS_USER_TITLE", "Find stale project items"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithNumber("days",
+				mcp.Required(),
+				mcp.Description("Items not updated for more than this many days are stale"),
+				mcp.Min(1),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				Days      int    `mapstructure:"days"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.Days <= 0 {
+				return mcp.NewToolResultError("days must be greater than 0"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			now := time.Now()
+			threshold := now.AddDate(0, 0, -params.Days)
+			var stale []projectItem
+			for _, item := range fetched.Items {
+				if item.Orphaned {
+					continue
+				}
+				if item.UpdatedAt.Before(threshold) {
+					stale = append(stale, item)
+				}
+			}
+			sort.SliceStable(stale, func(i, j int) bool {
+				return stale[i].UpdatedAt.Before(stale[j].UpdatedAt)
+			})
+
+			output := make([]map[string]interface{}, 0, len(stale))
+			for _, item := range stale {
+				output = append(output, map[string]interface{}{
+					"id":                item.ID,
+					"type":              item.Type,
+					"title":             item.Title,
+					"url":               item.URL,
+					"updated_at":        item.UpdatedAt,
+					"days_since_update": int(now.Sub(item.UpdatedAt).Hours() / 24),
+				})
+			}
+
+			response := map[string]interface{}{
+				"project_id":  params.ProjectID,
+				"days":        params.Days,
+				"threshold":   threshold.UTC().Format(time.RFC3339),
+				"total_items": len(fetched.Items),
+				"stale_count": len(output),
+				"items":       output,
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Assign the same people to many board items at once during sprint planning
+// EXPECTS: project_id, item_ids, assignees (logins)
+// RETURNS: Items assigned, items skipped (drafts, deleted content) and failures
+// INTEGRATION: Uses replaceActorsForAssignable like AssignCopilotToIssue, so each item's assignees become exactly the given list
+func SetProjectItemsAssignees(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_project_items_assignees",
+			mcp.WithDescription(t("TOOL_SET_PROJECT_ITEMS_ASSIGNEES_DESCRIPTION", "Set the assignees of the issues and pull requests behind several GitHub Projects v2 items at once. Each item's assignees are replaced with the given users. Draft issues and items whose content was deleted are skipped.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_PROJECT_ITEMS_ASSIGNEES_USER_TITLE", "Set project item assignees"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithArray("item_ids",
+				mcp.Required(),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+				mcp.Description("Project item IDs (PVTI_xxxx format) whose issues or pull requests should be assigned"),
+			),
+			mcp.WithArray("assignees",
+				mcp.Required(),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+				mcp.Description("GitHub logins to assign; an empty list removes all assignees"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string   `mapstructure:"project_id"`
+				ItemIDs   []string `mapstructure:"item_ids"`
+				Assignees []string `mapstructure:"assignees"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.ItemIDs) == 0 {
+				return mcp.NewToolResultError("item_ids must contain at least one item ID"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// UNDERSTANDING: Resolve each login to a node ID once, not once per item
+			actorIDs := make([]githubv4.ID, 0, len(params.Assignees))
+			for _, login := range params.Assignees {
+				var userQuery struct {
+					User struct {
+						ID githubv4.ID
+					} `graphql:"user(login: $login)"`
+				}
+				if err := client.Query(ctx, &userQuery, map[string]interface{}{
+					"login": githubv4.String(login),
+				}); err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to resolve assignee %s: %v", login, err)), nil
+				}
+				if userQuery.User.ID == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("user %s not found", login)), nil
+				}
+				actorIDs = append(actorIDs, userQuery.User.ID)
+			}
+
+			var assignMutation struct {
+				ReplaceActorsForAssignable struct {
+					Typename string `graphql:"__typename"`
+				} `graphql:"replaceActorsForAssignable(input: $input)"`
+			}
+
+			assigned := []string{}
+			skipped := []map[string]interface{}{}
+			failed := []map[string]interface{}{}
+			for _, itemID := range params.ItemIDs {
+				item, err := fetchProjectItem(ctx, client, itemID)
+				if err != nil {
+					failed = append(failed, map[string]interface{}{"item_id": itemID, "error": err.Error()})
+					continue
+				}
+
+				// VERIFIED: Only issues and pull requests are Assignable; draft assignees live on the draft itself
+				if (item.Type != "ISSUE" && item.Type != "PULL_REQUEST") || item.ContentID == "" {
+					reason := fmt.Sprintf("%s items cannot be assigned", strings.ToLower(item.Type))
+					if item.Orphaned {
+						reason = "the item's content was deleted"
+					}
+					skipped = append(skipped, map[string]interface{}{"item_id": itemID, "reason": reason})
+					continue
+				}
+
+				if err := client.Mutate(ctx, &assignMutation, ReplaceActorsForAssignableInput{
+					AssignableID: githubv4.ID(item.ContentID),
+					ActorIDs:     actorIDs,
+				}, nil); err != nil {
+					failed = append(failed, map[string]interface{}{"item_id": itemID, "error": err.Error()})
+					continue
+				}
+				assigned = append(assigned, itemID)
+			}
+
+			response := map[string]interface{}{
+				"success":   len(failed) == 0,
+				"message":   fmt.Sprintf("Set assignees on %d of %d item(s)", len(assigned), len(params.ItemIDs)),
+				"assignees": params.Assignees,
+				"assigned":  assigned,
+				"skipped":   skipped,
+				"failed":    failed,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// draftsRepositoryBucket collects items that are not backed by a repository.
+const draftsRepositoryBucket = "(drafts)"
+
+// UNDERSTANDING: Count how many items each source repository contributes to an aggregate board
+// EXPECTS: project_id, optional max_items
+// RETURNS: Map of "owner/repo" to item count, with draft issues under "(drafts)"
+// INTEGRATION: Orphaned and inaccessible items have no repository and are counted separately
+func GetProjectItemsByRepository(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_items_by_repository",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ITEMS_BY_REPOSITORY_DESCRIPTION", "Count the items on a GitHub Projects v2 board per source repository (owner/repo). Draft issues are counted under \"(drafts)\".")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ITEMS_BY_REPOSITORY_USER_TITLE", "Get project items by repository"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			counts := map[string]int{}
+			unattributed := 0
+			for _, item := range fetched.Items {
+				switch {
+				case item.Type == "DRAFT_ISSUE":
+					counts[draftsRepositoryBucket]++
+				case item.Repository != "":
+					counts[item.Repository]++
+				default:
+					unattributed++
+				}
+			}
+
+			response := map[string]interface{}{
+				"project_id":         params.ProjectID,
+				"total_items":        len(fetched.Items),
+				"repository_count":   len(counts) - min(counts[draftsRepositoryBucket], 1),
+				"items_by_repo":      counts,
+				"unattributed_items": unattributed,
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// unassignedBucket groups items without an assignee in get_project_assignee_workload.
+const unassignedBucket = "(unassigned)"
+
+// UNDERSTANDING: Enforce that every item on a board has a set of required fields filled in
+// EXPECTS: project_id, required_fields (field names), optional auto_fix (field name → default value) and max_items
+// RETURNS: Items missing required fields, and which of those were fixed with a default
+// INTEGRATION: Defaults go through coerceProjectFieldValue, so they follow the same rules as update_project_item_status
+func ValidateProjectItemsAgainstSchema(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("validate_project_items_against_schema",
+			mcp.WithDescription(t("TOOL_VALIDATE_PROJECT_ITEMS_AGAINST_SCHEMA_DESCRIPTION", "Check that every item on a GitHub Projects v2 board has a value for each required field and report the items that do not. When auto_fix provides a default for a field, items missing that field are set to the default.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_VALIDATE_PROJECT_ITEMS_AGAINST_SCHEMA_USER_TITLE", "Validate project items against required fields"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithArray("required_fields",
+				mcp.Required(),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+				mcp.Description("Names of the fields every item must have a value for"),
+			),
+			mcp.WithObject("auto_fix",
+				mcp.Description("Optional map of required field name to the default value to set on items missing it (e.g., {\"Status\": \"Todo\", \"Estimate\": \"1\"})"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID      string            `mapstructure:"project_id"`
+				RequiredFields []string          `mapstructure:"required_fields"`
+				AutoFix        map[string]string `mapstructure:"auto_fix"`
+				MaxItems       int               `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.RequiredFields) == 0 {
+				return mcp.NewToolResultError("required_fields must contain at least one field name"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			projectFields, err := fetchProjectFields(ctx, client, params.ProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+			}
+			required := make([]projectField, 0, len(params.RequiredFields))
+			for _, name := range params.RequiredFields {
+				field, ok := findProjectField(projectFields, name)
+				if !ok {
+					return mcp.NewToolResultError(fmt.Sprintf("field %q not found in project", name)), nil
+				}
+				required = append(required, field)
+			}
+
+			// VERIFIED: Defaults are coerced before any item is touched, so a bad default fails the whole call
+			defaults := map[string]coercedFieldValue{}
+			for name, raw := range params.AutoFix {
+				var field projectField
+				found := false
+				for _, candidate := range required {
+					if strings.EqualFold(candidate.Name, name) {
+						field, found = candidate, true
+						break
+					}
+				}
+				if !found {
+					return mcp.NewToolResultError(fmt.Sprintf("auto_fix field %q is not one of the required fields", name)), nil
+				}
+				coerced, err := coerceProjectFieldValue(field, raw)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid auto_fix value: %v", err)), nil
+				}
+				defaults[field.ID] = coerced
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			var updateFieldMutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+
+			violations := []map[string]interface{}{}
+			fixedCount := 0
+			for _, item := range fetched.Items {
+				// UNDERSTANDING: REDACTED and orphaned items carry no field values and cannot be fixed
+				if item.Orphaned || item.Type == "REDACTED" {
+					continue
+				}
+				missing := []string{}
+				fixed := []string{}
+				failed := map[string]string{}
+				for _, field := range required {
+					if value, ok := item.fieldValue(field.Name); ok && value.hasValue() {
+						continue
+					}
+					missing = append(missing, field.Name)
+					coerced, ok := defaults[field.ID]
+					if !ok {
+						continue
+					}
+					if err := client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+						ProjectID: githubv4.ID(params.ProjectID),
+						ItemID:    githubv4.ID(item.ID),
+						FieldID:   githubv4.ID(field.ID),
+						Value:     coerced.Input,
+					}, nil); err != nil {
+						failed[field.Name] = err.Error()
+						continue
+					}
+					fixed = append(fixed, field.Name)
+				}
+				if len(missing) == 0 {
+					continue
+				}
+				fixedCount += len(fixed)
+				violation := map[string]interface{}{
+					"item_id":        item.ID,
+					"type":           item.Type,
+					"title":          item.Title,
+					"missing_fields": missing,
+				}
+				if item.URL != "" {
+					violation["url"] = item.URL
+				}
+				if len(fixed) > 0 {
+					violation["fixed_fields"] = fixed
+				}
+				if len(failed) > 0 {
+					violation["failed_fields"] = failed
+				}
+				violations = append(violations, violation)
+			}
+
+			response := map[string]interface{}{
+				"project_id":      params.ProjectID,
+				"required_fields": params.RequiredFields,
+				"checked_items":   len(fetched.Items),
+				"violation_count": len(violations),
+				"violations":      violations,
+				"fixed_count":     fixedCount,
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// defaultProjectItemsPageSize is the page size list_project_items_page uses when page_size is not given.
+const defaultProjectItemsPageSize = 50
+
+// encodeProjectItemsCursor wraps GitHub's end cursor and the number of items already returned into an opaque cursor.
+// UNDERSTANDING: Carrying the offset lets positions continue across pages without the client tracking them
+func encodeProjectItemsCursor(offset int, endCursor string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset) + ":" + endCursor))
+}
+
+// decodeProjectItemsCursor reverses encodeProjectItemsCursor.
+func decodeProjectItemsCursor(cursor string) (int, string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid cursor %q", cursor)
+	}
+	offsetPart, endCursor, ok := strings.Cut(string(raw), ":")
+	offset, err := strconv.Atoi(offsetPart)
+	if !ok || err != nil || offset < 0 || endCursor == "" {
+		return 0, "", fmt.Errorf("invalid cursor %q", cursor)
+	}
+	return offset, endCursor, nil
+}
+
+// UNDERSTANDING: Return exactly one page of board items so the client drives pagination on very large boards
+// EXPECTS: project_id, optional cursor (from a previous call's next_cursor) and page_size (1-100, default 50)
+// RETURNS: One page of normalized items, has_next_page and an opaque next_cursor
+// INTEGRATION: Paged counterpart to list_project_items, which buffers the whole board server-side
+func ListProjectItemsPage(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_items_page",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_ITEMS_PAGE_DESCRIPTION", "List one page of items on a GitHub Projects v2 board in manual board order. Pass the returned next_cursor back as cursor to fetch the following page; when has_next_page is false the board has been fully listed. Items have the same shape as in list_project_items.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_ITEMS_PAGE_USER_TITLE", "List a page of project items"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("cursor",
+				mcp.Description("Opaque cursor returned as next_cursor by a previous call; omit to start from the first item"),
+			),
+			mcp.WithNumber("page_size",
+				mcp.Description(fmt.Sprintf("Number of items per page (default: %d)", defaultProjectItemsPageSize)),
+				mcp.Min(1),
+				mcp.Max(100),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				Cursor    string `mapstructure:"cursor"`
+				PageSize  int    `mapstructure:"page_size"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.PageSize == 0 {
+				params.PageSize = defaultProjectItemsPageSize
+			}
+			if params.PageSize < 1 || params.PageSize > 100 {
+				return mcp.NewToolResultError("page_size must be between 1 and 100"), nil
+			}
+
+			offset := 0
+			var after *githubv4.String
+			if params.Cursor != "" {
+				var endCursor string
+				var err error
+				if offset, endCursor, err = decodeProjectItemsCursor(params.Cursor); err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				after = githubv4.NewString(githubv4.String(endCursor))
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var query projectItemsQuery
+			if err := client.Query(ctx, &query, map[string]interface{}{
+				"projectId": githubv4.ID(params.ProjectID),
+				"first":     githubv4.Int(params.PageSize), // #nosec G115 - bounded by 100
+				"after":     after,
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			connection := query.Node.ProjectV2.Items
+			items := make([]projectItem, 0, len(connection.Nodes))
+			for _, node := range connection.Nodes {
+				if err := completeProjectItemFieldValues(ctx, client, &node); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+				}
+				item := newProjectItem(node)
+				item.Position = offset + len(items) + 1
+				items = append(items, item)
+			}
+			output, orphaned := projectItemsOutput(items)
+
+			response := map[string]interface{}{
+				"project_id":     params.ProjectID,
+				"total_count":    int(connection.TotalCount),
+				"page_count":     len(items),
+				"orphaned_count": orphaned,
+				"items":          output,
+				"has_next_page":  bool(connection.PageInfo.HasNextPage),
+			}
+			if connection.PageInfo.HasNextPage {
+				response["next_cursor"] = encodeProjectItemsCursor(offset+len(items), string(connection.PageInfo.EndCursor))
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Report the workflow order of a board's status columns (e.g., Todo → In Progress → Done)
+// EXPECTS: project_id, optional status_field_name (default "Status")
+// RETURNS: The status options with their 1-based position, in the order defined on the field
+// INTEGRATION: Built on fetchProjectStatusField so status-moving tools share the same notion of order
+func GetProjectStatusOrder(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_status_order",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_STATUS_ORDER_DESCRIPTION", "Get the options of a GitHub Projects v2 status field in the order they are defined, which is the board's column (workflow) order.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_STATUS_ORDER_USER_TITLE", "Get project status order"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Name of the single-select status field (default: 'Status')"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string `mapstructure:"project_id"`
+				StatusFieldName string `mapstructure:"status_field_name"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			field, err := fetchProjectStatusField(ctx, client, params.ProjectID, params.StatusFieldName)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			options := make([]map[string]interface{}, 0, len(field.Options))
+			for i, option := range field.Options {
+				options = append(options, map[string]interface{}{
+					"position": i + 1,
+					"id":       option.ID,
+					"name":     option.Name,
+				})
+			}
+
+			response := map[string]interface{}{
+				"project_id": params.ProjectID,
+				"field_id":   field.ID,
+				"field_name": field.Name,
+				"options":    options,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// repositoryIssuesQuery pages through a repository's issues for bulk-adding them to a project.
+type repositoryIssuesQuery struct {
+	Repository struct {
+		Issues struct {
+			Nodes []struct {
+				ID     githubv4.ID
+				Number githubv4.Int
+			}
+			PageInfo struct {
+				HasNextPage githubv4.Boolean
+				EndCursor   githubv4.String
+			}
+		} `graphql:"issues(first: $first, after: $after, states: $states, orderBy: {field: CREATED_AT, direction: ASC})"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// UNDERSTANDING: Onboard a repository to a board by adding all of its (open) issues
+// EXPECTS: owner, repo, project_id, optional state ("open", "closed" or "all"; default "open") and max_items
+// RETURNS: How many issues were added, plus issues that failed with their error
+// INTEGRATION: addProjectV2ItemById is idempotent, so issues already on the board are returned rather than duplicated
+func AddRepositoryIssuesToProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("add_repository_issues_to_project",
+			mcp.WithDescription(t("TOOL_ADD_REPOSITORY_ISSUES_TO_PROJECT_DESCRIPTION", "Add every issue of a repository to a GitHub Projects v2 board, by default only open issues. Issues already on the board are not duplicated.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_REPOSITORY_ISSUES_TO_PROJECT_USER_TITLE", "Add repository issues to project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("state",
+				mcp.Description("Which issues to add (default: 'open')"),
+				mcp.Enum("open", "closed", "all"),
+			),
+			mcp.WithNumber("max_items",
+				mcp.Description(fmt.Sprintf("Maximum number of issues to add before stopping (default: %d)", defaultMaxProjectItems)),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				Owner     string `mapstructure:"owner"`
+				Repo      string `mapstructure:"repo"`
+				ProjectID string `mapstructure:"project_id"`
+				State     string `mapstructure:"state"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.State == "" {
+				params.State = "open"
+			}
+			if params.MaxItems <= 0 {
+				params.MaxItems = defaultMaxProjectItems
+			}
+
+			var states []githubv4.IssueState
+			switch params.State {
+			case "open":
+				states = []githubv4.IssueState{githubv4.IssueStateOpen}
+			case "closed":
+				states = []githubv4.IssueState{githubv4.IssueStateClosed}
+			case "all":
+				states = []githubv4.IssueState{githubv4.IssueStateOpen, githubv4.IssueStateClosed}
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("invalid state %q: must be one of open, closed, all", params.State)), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var addItemMutation struct {
+				AddProjectV2ItemById struct {
+					Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"addProjectV2ItemById(input: $input)"`
+			}
+
+			added := []map[string]interface{}{}
+			failed := []map[string]interface{}{}
+			processed := 0
+			truncated := false
+			var after *githubv4.String
+			for {
+				var query repositoryIssuesQuery
+				if err := client.Query(ctx, &query, map[string]interface{}{
+					"owner":  githubv4.String(params.Owner),
+					"repo":   githubv4.String(params.Repo),
+					"states": states,
+					"first":  githubv4.Int(min(100, params.MaxItems-processed)), // #nosec G115 - bounded by 100
+					"after":  after,
+				}); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to list repository issues", err), nil
+				}
+
+				for _, issue := range query.Repository.Issues.Nodes {
+					processed++
+					if err := client.Mutate(ctx, &addItemMutation, githubv4.AddProjectV2ItemByIdInput{
+						ProjectID: githubv4.ID(params.ProjectID),
+						ContentID: issue.ID,
+					}, nil); err != nil {
+						failed = append(failed, map[string]interface{}{
+							"number": int(issue.Number),
+							"error":  err.Error(),
+						})
+						continue
+					}
+					added = append(added, map[string]interface{}{
+						"number":  int(issue.Number),
+						"item_id": addItemMutation.AddProjectV2ItemById.Item.ID,
+					})
+				}
+
+				pageInfo := query.Repository.Issues.PageInfo
+				if !pageInfo.HasNextPage {
+					break
+				}
+				if processed >= params.MaxItems {
+					truncated = true
+					break
+				}
+				cursor := pageInfo.EndCursor
+				after = &cursor
+			}
+
+			response := map[string]interface{}{
+				"success":      len(failed) == 0,
+				"message":      fmt.Sprintf("Added %d %s issue(s) from %s/%s to project", len(added), params.State, params.Owner, params.Repo),
+				"added_count":  len(added),
+				"failed_count": len(failed),
+				"added":        added,
+				"failed":       failed,
+				"truncated":    truncated,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Find issues and pull requests that sprawl across several of the given boards
+// EXPECTS: project_ids (two or more project node IDs), optional max_items per project
+// RETURNS: Content that appears on more than one of the projects, with its item ID on each
+// INTEGRATION: Matches on content ID, so the same issue is recognized regardless of its item IDs; drafts are board-local and ignored
+func FindMultiProjectItems(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("find_multi_project_items",
+			mcp.WithDescription(t("TOOL_FIND_MULTI_PROJECT_ITEMS_DESCRIPTION", "Find issues and pull requests that are on more than one of the given GitHub Projects v2 boards.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FIND_MULTI_PROJECT_ITEMS_USER_TITLE", "Find items on multiple projects"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithArray("project_ids",
+				mcp.Required(),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+				mcp.Description("GitHub Projects v2 project IDs to compare (at least two)"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectIDs []string `mapstructure:"project_ids"`
+				MaxItems   int      `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.ProjectIDs) < 2 {
+				return mcp.NewToolResultError("project_ids must contain at least two project IDs"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			type placement struct {
+				ProjectID string `json:"project_id"`
+				ItemID    string `json:"item_id"`
+			}
+			type content struct {
+				ContentID string      `json:"content_id"`
+				Type      string      `json:"type"`
+				Title     string      `json:"title"`
+				URL       string      `json:"url"`
+				Projects  []placement `json:"projects"`
+			}
+
+			contents := map[string]*content{}
+			order := []string{}
+			truncatedProjects := []string{}
+			for _, projectID := range params.ProjectIDs {
+				fetched, err := fetchAllProjectItems(ctx, client, projectID, params.MaxItems)
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("failed to get items for project %s: %v", projectID, err)), nil
+				}
+				if fetched.Truncated {
+					truncatedProjects = append(truncatedProjects, projectID)
+				}
+				for _, item := range fetched.Items {
+					if (item.Type != "ISSUE" && item.Type != "PULL_REQUEST") || item.ContentID == "" {
+						continue
+					}
+					c, ok := contents[item.ContentID]
+					if !ok {
+						c = &content{ContentID: item.ContentID, Type: item.Type, Title: item.Title, URL: item.URL}
+						contents[item.ContentID] = c
+						order = append(order, item.ContentID)
+					}
+					c.Projects = append(c.Projects, placement{ProjectID: projectID, ItemID: item.ID})
+				}
+			}
+
+			// VERIFIED: Stable sort keeps first-seen order among content on the same number of boards
+			shared := []*content{}
+			for _, contentID := range order {
+				if c := contents[contentID]; len(c.Projects) > 1 {
+					shared = append(shared, c)
+				}
+			}
+			sort.SliceStable(shared, func(i, j int) bool {
+				return len(shared[i].Projects) > len(shared[j].Projects)
+			})
+
+			response := map[string]interface{}{
+				"project_ids":         params.ProjectIDs,
+				"unique_content":      len(contents),
+				"multi_project":       shared,
+				"multi_project_count": len(shared),
+				"truncated":           len(truncatedProjects) > 0,
+			}
+			if len(truncatedProjects) > 0 {
+				response["truncated_projects"] = truncatedProjects
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// projectExportVersion identifies the layout of projectExport documents.
+const projectExportVersion = 1
+
+// projectExport is a complete JSON backup of a board.
+// UNDERSTANDING: Field and item shapes match get_project_fields/list_project_items so an import can reuse them
+type projectExport struct {
+	Version    int                  `json:"version"`
+	ExportedAt time.Time            `json:"exported_at"`
+	Project    projectExportProject `json:"project"`
+	Fields     []projectField       `json:"fields"`
+	Items      []projectExportItem  `json:"items"`
+	Truncated  bool                 `json:"truncated"`
+}
+
+// projectExportItem is a list_project_items item plus the body of a draft issue, which an import recreates.
+type projectExportItem struct {
+	projectItem
+	Body string `json:"body,omitempty"`
+}
+
+// projectExportProject holds the board-level settings of an export.
+type projectExportProject struct {
+	ID               string `json:"id"`
+	Title            string `json:"title"`
+	ShortDescription string `json:"short_description"`
+	Readme           string `json:"readme"`
+	Public           bool   `json:"public"`
+}
+
+// UNDERSTANDING: Back up a whole board (settings, fields with options, and every item's field values) as one JSON document
+// EXPECTS: project_id, optional max_items
+// RETURNS: A versioned export document
+// INTEGRATION: The document is the input format for importing a board into a new project
+func ExportProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("export_project",
+			mcp.WithDescription(t("TOOL_EXPORT_PROJECT_DESCRIPTION", "Export a GitHub Projects v2 board as a JSON backup document, including its settings, fields with their options and iterations, and every item with its field values. The document can later be imported to recreate the board.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_EXPORT_PROJECT_USER_TITLE", "Export project"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var projectQuery struct {
+				Node struct {
+					ProjectV2 struct {
+						ID               githubv4.ID
+						Title            githubv4.String
+						ShortDescription githubv4.String
+						Readme           githubv4.String
+						Public           githubv4.Boolean
+					} `graphql:"... on ProjectV2"`
+				} `graphql:"node(id: $id)"`
+			}
+			if err := client.Query(ctx, &projectQuery, map[string]interface{}{
+				"id": githubv4.ID(params.ProjectID),
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project", err), nil
+			}
+			project := projectQuery.Node.ProjectV2
+			if project.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project %s not found", params.ProjectID)), nil
+			}
+
+			fields, err := fetchProjectFields(ctx, client, params.ProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			items := make([]projectExportItem, 0, len(fetched.Items))
+			for _, item := range fetched.Items {
+				items = append(items, projectExportItem{projectItem: item, Body: item.DraftBody})
+			}
+
+			export := projectExport{
+				Version:    projectExportVersion,
+				ExportedAt: time.Now().UTC(),
+				Project: projectExportProject{
+					ID:               fmt.Sprint(project.ID),
+					Title:            string(project.Title),
+					ShortDescription: string(project.ShortDescription),
+					Readme:           string(project.Readme),
+					Public:           bool(project.Public),
+				},
+				Fields:    fields,
+				Items:     items,
+				Truncated: fetched.Truncated,
+			}
+
+			responseJSON, err := json.Marshal(export)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// importableFieldTypes are the field types createProjectV2Field accepts.
+// VERIFIED: Iteration fields cannot be created through the API, so they are reported as skipped
+var importableFieldTypes = map[string]bool{
+	"TEXT":          true,
+	"NUMBER":        true,
+	"DATE":          true,
+	"SINGLE_SELECT": true,
+}
+
+// rawFieldValue turns an exported field value back into the string form coerceProjectFieldValue accepts.
+func rawFieldValue(value projectFieldValue) string {
+	if number, ok := value.Value.(float64); ok {
+		return strconv.FormatFloat(number, 'f', -1, 64)
+	}
+	return fmt.Sprint(value.Value)
+}
+
+// UNDERSTANDING: Recreate a board from an export_project document in a new project
+// EXPECTS: owner_id, export (the JSON document), optional title to override the exported title
+// RETURNS: The new project plus old → new ID mappings for the project, fields, options and items
+// INTEGRATION: Only draft issues are recreated; linked issues and pull requests belong to repositories and are reported as skipped
+func ImportProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("import_project",
+			mcp.WithDescription(t("TOOL_IMPORT_PROJECT_DESCRIPTION", "Create a new GitHub Projects v2 board from a document produced by export_project. The project's description, README and visibility, its text, number, date and single-select fields, and its draft issues with their bodies and field values are recreated. Linked issues and pull requests cannot be recreated and are reported as skipped.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_IMPORT_PROJECT_USER_TITLE", "Import project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("owner_id",
+				mcp.Required(),
+				mcp.Description("GitHub node ID of the user or organization who will own the new project (use get_me to find your user ID)"),
+			),
+			mcp.WithString("export",
+				mcp.Required(),
+				mcp.Description("JSON document returned by export_project"),
+			),
+			mcp.WithString("title",
+				mcp.Description("Title for the new project (default: the exported title)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				OwnerID string `mapstructure:"owner_id"`
+				Export  string `mapstructure:"export"`
+				Title   string `mapstructure:"title"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var export projectExport
+			if err := json.Unmarshal([]byte(params.Export), &export); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid export document: %v", err)), nil
+			}
+			if export.Version != projectExportVersion {
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported export version %d (expected %d)", export.Version, projectExportVersion)), nil
+			}
+			if params.Title == "" {
+				params.Title = export.Project.Title
+			}
+			if params.Title == "" {
+				return mcp.NewToolResultError("title is required when the export has no project title"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var createProjectMutation struct {
+				CreateProjectV2 struct {
+					ProjectV2 struct {
+						ID     githubv4.ID
+						Number githubv4.Int
+						URL    githubv4.String
+					}
+				} `graphql:"createProjectV2(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &createProjectMutation, githubv4.CreateProjectV2Input{
+				OwnerID: githubv4.ID(params.OwnerID),
+				Title:   githubv4.String(params.Title),
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to create project", err), nil
+			}
+			project := createProjectMutation.CreateProjectV2.ProjectV2
+			projectID := fmt.Sprint(project.ID)
+
+			// UNDERSTANDING: From here on the project exists, so failures are collected rather than aborting the import
+			failed := []map[string]interface{}{}
+
+			// UNDERSTANDING: New projects start private, so visibility only needs restoring for public exports
+			if export.Project.ShortDescription != "" || export.Project.Readme != "" || export.Project.Public {
+				var updateProjectMutation struct {
+					UpdateProjectV2 struct {
+						ProjectV2 struct {
+							ID githubv4.ID
+						}
+					} `graphql:"updateProjectV2(input: $input)"`
+				}
+				input := githubv4.UpdateProjectV2Input{ProjectID: project.ID}
+				if export.Project.ShortDescription != "" {
+					input.ShortDescription = githubv4.NewString(githubv4.String(export.Project.ShortDescription))
+				}
+				if export.Project.Readme != "" {
+					input.Readme = githubv4.NewString(githubv4.String(export.Project.Readme))
+				}
+				if export.Project.Public {
+					input.Public = githubv4.NewBoolean(true)
+				}
+				if err := client.Mutate(ctx, &updateProjectMutation, input, nil); err != nil {
+					failed = append(failed, map[string]interface{}{"project_id": projectID, "error": err.Error()})
+				}
+			}
+
+			var createFieldMutation struct {
+				CreateProjectV2Field struct {
+					ProjectV2Field projectFieldNode
+				} `graphql:"createProjectV2Field(input: $input)"`
+			}
+
+			fieldIDs := map[string]string{}
+			optionIDs := map[string]string{}
+			newFields := map[string]projectField{}
+			skippedFields := []string{}
+			for _, field := range export.Fields {
+				if !importableFieldTypes[field.DataType] {
+					if customFieldTypes[field.DataType] {
+						skippedFields = append(skippedFields, field.Name)
+					}
+					continue
+				}
+				input := githubv4.CreateProjectV2FieldInput{
+					ProjectID: project.ID,
+					DataType:  githubv4.ProjectV2CustomFieldType(field.DataType),
+					Name:      githubv4.String(field.Name),
+				}
+				if field.DataType == "SINGLE_SELECT" {
+					options := newSingleSelectOptionInputs(projectSingleSelectField{Options: field.Options}.optionInputs())
+					input.SingleSelectOptions = &options
+				}
+				if err := client.Mutate(ctx, &createFieldMutation, input, nil); err != nil {
+					failed = append(failed, map[string]interface{}{"field_id": field.ID, "field_name": field.Name, "error": err.Error()})
+					continue
+				}
+				created := newProjectField(createFieldMutation.CreateProjectV2Field.ProjectV2Field)
+				fieldIDs[field.ID] = created.ID
+				newFields[field.ID] = created
+				for _, oldOption := range field.Options {
+					for _, newOption := range created.Options {
+						if newOption.Name == oldOption.Name {
+							optionIDs[string(oldOption.ID)] = string(newOption.ID)
+							break
+						}
+					}
+				}
+			}
+
+			var addDraftMutation struct {
+				AddProjectV2DraftIssue struct {
+					ProjectItem struct {
+						ID githubv4.ID
+					}
+				} `graphql:"addProjectV2DraftIssue(input: $input)"`
+			}
+			var updateFieldMutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+
+			itemIDs := map[string]string{}
+			skippedItems := []map[string]interface{}{}
+			for _, item := range export.Items {
+				if item.Type != "DRAFT_ISSUE" {
+					skipped := map[string]interface{}{"item_id": item.ID, "type": item.Type}
+					if item.URL != "" {
+						skipped["url"] = item.URL
+					}
+					skippedItems = append(skippedItems, skipped)
+					continue
+				}
+				draftInput := githubv4.AddProjectV2DraftIssueInput{
+					ProjectID: project.ID,
+					Title:     githubv4.String(item.Title),
+				}
+				if item.Body != "" {
+					draftInput.Body = githubv4.NewString(githubv4.String(item.Body))
+				}
+				if err := client.Mutate(ctx, &addDraftMutation, draftInput, nil); err != nil {
+					failed = append(failed, map[string]interface{}{"item_id": item.ID, "error": err.Error()})
+					continue
+				}
+				newItemID := addDraftMutation.AddProjectV2DraftIssue.ProjectItem.ID
+				itemIDs[item.ID] = fmt.Sprint(newItemID)
+
+				for _, value := range item.FieldValues {
+					field, ok := newFields[value.FieldID]
+					if !ok || !value.hasValue() {
+						continue
+					}
+					coerced, err := coerceProjectFieldValue(field, rawFieldValue(value))
+					if err == nil {
+						err = client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+							ProjectID: project.ID,
+							ItemID:    newItemID,
+							FieldID:   githubv4.ID(field.ID),
+							Value:     coerced.Input,
+						}, nil)
+					}
+					if err != nil {
+						failed = append(failed, map[string]interface{}{"item_id": item.ID, "field_name": field.Name, "error": err.Error()})
+					}
+				}
+			}
+
+			response := map[string]interface{}{
+				"success":        len(failed) == 0,
+				"message":        fmt.Sprintf("Imported %d field(s) and %d draft issue(s) into a new project", len(fieldIDs), len(itemIDs)),
+				"project_id":     projectID,
+				"project_number": int(project.Number),
+				"url":            project.URL,
+				"id_map": map[string]interface{}{
+					"project": map[string]string{export.Project.ID: projectID},
+					"fields":  fieldIDs,
+					"options": optionIDs,
+					"items":   itemIDs,
+				},
+				"skipped_fields": skippedFields,
+				"skipped_items":  skippedItems,
+				"failed":         failed,
+			}
+			if len(skippedItems) > 0 {
+				response["note"] = "Linked issues and pull requests cannot be recreated by an import; add them to the new project with add_item_to_project"
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: List what got done since a point in time, e.g. since the last release, for release notes
+// EXPECTS: project_id, done_status (Status option that means "done"), since (ISO 8601), optional status_field_name and max_items
+// RETURNS: Items currently in the done status whose project item was updated after since, most recent first
+// INTEGRATION: The item's updatedAt moves when its status changes, so it approximates when the item was completed
+func ListRecentlyCompletedProjectItems(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_recently_completed_project_items",
+			mcp.WithDescription(t("TOOL_LIST_RECENTLY_COMPLETED_PROJECT_ITEMS_DESCRIPTION", "List items on a GitHub Projects v2 board that are in the done status and were updated after a given time, most recent first. Useful for release notes.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_RECENTLY_COMPLETED_PROJECT_ITEMS_USER_TITLE", "List recently completed project items"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("done_status",
+				mcp.Required(),
+				mcp.Description("Status option name that means an item is done (e.g., 'Done')"),
+			),
+			mcp.WithString("since",
+				mcp.Required(),
+				mcp.Description("Only include items updated after this time (ISO 8601 timestamp, e.g. 2024-06-01 or 2024-06-01T00:00:00Z)"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Name of the single-select status field (default: 'Status')"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string `mapstructure:"project_id"`
+				DoneStatus      string `mapstructure:"done_status"`
+				Since           string `mapstructure:"since"`
+				StatusFieldName string `mapstructure:"status_field_name"`
+				MaxItems        int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+			since, err := parseISOTimestamp(params.Since)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("invalid since: %v", err)), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			var completed []projectItem
+			for _, item := range fetched.Items {
+				if item.Orphaned || !item.UpdatedAt.After(since) {
+					continue
+				}
+				if strings.EqualFold(item.singleSelectName(params.StatusFieldName), params.DoneStatus) {
+					completed = append(completed, item)
+				}
+			}
+			sort.SliceStable(completed, func(i, j int) bool {
+				return completed[i].UpdatedAt.After(completed[j].UpdatedAt)
+			})
+
+			output := make([]map[string]interface{}, 0, len(completed))
+			for _, item := range completed {
+				entry := map[string]interface{}{
+					"id":         item.ID,
+					"type":       item.Type,
+					"title":      item.Title,
+					"url":        item.URL,
+					"repository": item.Repository,
+					"number":     item.Number,
+					"updated_at": item.UpdatedAt,
+				}
+				if item.ClosedAt != nil {
+					entry["closed_at"] = item.ClosedAt
+				}
+				output = append(output, entry)
+			}
+
+			response := map[string]interface{}{
+				"project_id":      params.ProjectID,
+				"done_status":     params.DoneStatus,
+				"since":           since.UTC().Format(time.RFC3339),
+				"completed_count": len(output),
+				"items":           output,
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// convertedDraftIssue is a project item after convertProjectV2DraftIssueItemToIssue, with the new issue as content.
+type convertedDraftIssue struct {
+	ID      githubv4.ID
+	Content struct {
+		Issue struct {
+			ID         githubv4.ID
+			Number     githubv4.Int
+			URL        githubv4.String
+			Repository struct {
+				NameWithOwner githubv4.String
+			}
+		} `graphql:"... on Issue"`
+	}
+}
+
+// convertDraftIssue turns a draft issue item into an issue in the given repository. The item keeps its ID and field values.
+func convertDraftIssue(ctx context.Context, client *githubv4.Client, itemID, repositoryID string) (convertedDraftIssue, error) {
+	var convertMutation struct {
+		ConvertProjectV2DraftIssueItemToIssue struct {
+			Item convertedDraftIssue
+		} `graphql:"convertProjectV2DraftIssueItemToIssue(input: $input)"`
+	}
+	if err := client.Mutate(ctx, &convertMutation, githubv4.ConvertProjectV2DraftIssueItemToIssueInput{
+		ItemID:       githubv4.ID(itemID),
+		RepositoryID: githubv4.ID(repositoryID),
+	}, nil); err != nil {
+		return convertedDraftIssue{}, err
+	}
+	return convertMutation.ConvertProjectV2DraftIssueItemToIssue.Item, nil
+}
+
+// UNDERSTANDING: Associate a board item with a repository on an aggregate board
+// EXPECTS: project_id, item_id, repository_id (repository node ID)
+// RETURNS: The issue the draft was converted into, or a clear error for items whose repository cannot change
+// INTEGRATION: The built-in Repository field follows the item's content and is not writable, so drafts are converted instead
+func SetProjectItemRepository(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_project_item_repository",
+			mcp.WithDescription(t("TOOL_SET_PROJECT_ITEM_REPOSITORY_DESCRIPTION", "Set the repository of a GitHub Projects v2 item. The Repository field follows the item's content, so this is only supported for draft issues, which are converted into an issue in the given repository. The repository of an existing issue or pull request cannot be changed.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_PROJECT_ITEM_REPOSITORY_USER_TITLE", "Set project item repository"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format) of a draft issue"),
+			),
+			mcp.WithString("repository_id",
+				mcp.Required(),
+				mcp.Description("GitHub repository node ID (R_xxxx format) to create the issue in"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID    string `mapstructure:"project_id"`
+				ItemID       string `mapstructure:"item_id"`
+				RepositoryID string `mapstructure:"repository_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			item, err := fetchProjectItem(ctx, client, params.ItemID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+			}
+			if item.Type != "DRAFT_ISSUE" {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s is a %s in %s; the Repository field follows the item's content and cannot be changed through the Projects API (only draft issues can be given a repository, by converting them to issues)", item.ID, item.Type, item.Repository)), nil
+			}
+
+			converted, err := convertDraftIssue(ctx, client, params.ItemID, params.RepositoryID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to convert draft issue", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":      true,
+				"message":      fmt.Sprintf("Draft issue converted to an issue in %s", converted.Content.Issue.Repository.NameWithOwner),
+				"project_id":   params.ProjectID,
+				"item_id":      converted.ID,
+				"content_id":   converted.Content.Issue.ID,
+				"issue_number": int(converted.Content.Issue.Number),
+				"url":          converted.Content.Issue.URL,
+				"repository":   converted.Content.Issue.Repository.NameWithOwner,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// checklistTask is a single markdown task list entry.
+type checklistTask struct {
+	Text string `json:"text"`
+	Done bool   `json:"done"`
+}
+
+// parseChecklist extracts markdown task list entries ("- [ ] task" / "- [x] task") from a body.
+func parseChecklist(body string) []checklistTask {
+	tasks := []checklistTask{}
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) < 2 || !strings.ContainsRune("-*+", rune(line[0])) || line[1] != ' ' {
+			continue
+		}
+		rest := strings.TrimLeft(line[1:], " ")
+		if len(rest) < 3 || rest[0] != '[' || rest[2] != ']' {
+			continue
+		}
+		var done bool
+		switch rest[1] {
+		case ' ':
+			done = false
+		case 'x', 'X':
+			done = true
+		default:
+			continue
+		}
+		tasks = append(tasks, checklistTask{Text: strings.TrimSpace(rest[3:]), Done: done})
+	}
+	return tasks
+}
+
+// UNDERSTANDING: Report how far along an item's markdown checklist is (e.g., 2 of 3 sub-tasks done)
+// EXPECTS: project_id, item_id
+// RETURNS: done, total and percent_complete, plus each checklist entry
+// INTEGRATION: Works for draft issues, issues and pull requests, since all three have a markdown body
+func GetProjectItemChecklistProgress(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_item_checklist_progress",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ITEM_CHECKLIST_PROGRESS_DESCRIPTION", "Get the progress of the markdown checklist ('- [ ]' / '- [x]' items) in the body of a GitHub Projects v2 item's draft issue, issue or pull request.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ITEM_CHECKLIST_PROGRESS_USER_TITLE", "Get project item checklist progress"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				ItemID    string `mapstructure:"item_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var itemQuery struct {
+				Node struct {
+					ProjectV2Item struct {
+						ID      githubv4.ID
+						Type    githubv4.String
+						Content *struct {
+							DraftIssue struct {
+								Title githubv4.String
+								Body  githubv4.String
+							} `graphql:"... on DraftIssue"`
+							Issue struct {
+								Title githubv4.String
+								Body  githubv4.String
+							} `graphql:"... on Issue"`
+							PullRequest struct {
+								Title githubv4.String
+								Body  githubv4.String
+							} `graphql:"... on PullRequest"`
+						}
+					} `graphql:"... on ProjectV2Item"`
+				} `graphql:"node(id: $id)"`
+			}
+			if err := client.Query(ctx, &itemQuery, map[string]interface{}{
+				"id": githubv4.ID(params.ItemID),
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+			}
+			item := itemQuery.Node.ProjectV2Item
+			if item.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s not found or is not a project item", params.ItemID)), nil
+			}
+			if item.Content == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s has no accessible content", params.ItemID)), nil
+			}
+
+			var title, body githubv4.String
+			switch item.Type {
+			case "DRAFT_ISSUE":
+				title, body = item.Content.DraftIssue.Title, item.Content.DraftIssue.Body
+			case "ISSUE":
+				title, body = item.Content.Issue.Title, item.Content.Issue.Body
+			case "PULL_REQUEST":
+				title, body = item.Content.PullRequest.Title, item.Content.PullRequest.Body
+			}
+
+			tasks := parseChecklist(string(body))
+			done := 0
+			for _, task := range tasks {
+				if task.Done {
+					done++
+				}
+			}
+			percent := 0.0
+			if len(tasks) > 0 {
+				percent = roundTo2(float64(done) / float64(len(tasks)) * 100)
+			}
+
+			response := map[string]interface{}{
+				"item_id":          params.ItemID,
+				"type":             item.Type,
+				"title":            title,
+				"done":             done,
+				"total":            len(tasks),
+				"percent_complete": percent,
+				"tasks":            tasks,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// withStatusFieldParams adds the status field parameters shared by the batch status tools.
+func withStatusFieldParams() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithString("field_id",
+			mcp.Description("Single-select field ID to update"),
+		),
+		mcp.WithString("field_name",
+			mcp.Description("Name of the single-select field to update, instead of field_id (default: 'Status')"),
+		),
+	}
+}
+
+// projectStatusUpdate is one item's resolved status change in a batch.
+type projectStatusUpdate struct {
+	ItemID string
+	Option coercedFieldValue
+}
+
+// applyProjectStatusUpdates sets each item's status and reports which items were updated or failed.
+// UNDERSTANDING: Every field and option is resolved before this is called, so a typo never leaves a half-applied batch
+func applyProjectStatusUpdates(ctx context.Context, client *githubv4.Client, projectID string, field projectField, updates []projectStatusUpdate) map[string]interface{} {
+	var updateFieldMutation struct {
+		UpdateProjectV2ItemFieldValue struct {
+			ProjectV2Item struct {
+				ID githubv4.ID
+			}
+		} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+	}
+
+	updated := []map[string]interface{}{}
+	failed := []map[string]interface{}{}
+	for _, update := range updates {
+		if err := client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+			ProjectID: githubv4.ID(projectID),
+			ItemID:    githubv4.ID(update.ItemID),
+			FieldID:   githubv4.ID(field.ID),
+			Value:     update.Option.Input,
+		}, nil); err != nil {
+			failed = append(failed, map[string]interface{}{
+				"item_id": update.ItemID,
+				"error":   err.Error(),
+			})
+			continue
+		}
+		updated = append(updated, map[string]interface{}{
+			"item_id":     update.ItemID,
+			"option_id":   update.Option.OptionID,
+			"option_name": update.Option.Value,
+		})
+	}
+
+	return map[string]interface{}{
+		"success":       len(failed) == 0,
+		"message":       fmt.Sprintf("Updated %s on %d of %d item(s)", field.Name, len(updated), len(updates)),
+		"field_id":      field.ID,
+		"field_name":    field.Name,
+		"updated_count": len(updated),
+		"updated":       updated,
+		"failed":        failed,
+	}
+}
+
+// UNDERSTANDING: Move several items to the same status in one call (e.g., close out a sprint)
+// EXPECTS: project_id, item_ids, field_id or field_name, option_id or option_name
+// RETURNS: Items updated and items that failed with their error
+// INTEGRATION: The field and option are resolved once, before any item is touched
+func UpdateProjectItemsStatus(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_UPDATE_PROJECT_ITEMS_STATUS_DESCRIPTION", "Set the same single-select value (e.g., Status = Done) on several GitHub Projects v2 items at once. The field and option can be given by ID or by name; names are resolved once before any item is updated.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_UPDATE_PROJECT_ITEMS_STATUS_USER_TITLE", "Update status of project items"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+		mcp.WithString("project_id",
+			mcp.Required(),
+			mcp.Description("GitHub Projects v2 project ID"),
+		),
+		mcp.WithArray("item_ids",
+			mcp.Required(),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+			mcp.Description("Project item IDs (PVTI_xxxx format) to update"),
+		),
+	}
+	options = append(options, withStatusFieldParams()...)
+	options = append(options,
+		mcp.WithString("option_id",
+			mcp.Description("Option ID to set"),
+		),
+		mcp.WithString("option_name",
+			mcp.Description("Option name to set, instead of option_id (matched ignoring case and spacing)"),
+		),
+	)
+
+	return mcp.NewTool("update_project_items_status", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID  string   `mapstructure:"project_id"`
+				ItemIDs    []string `mapstructure:"item_ids"`
+				FieldID    string   `mapstructure:"field_id"`
+				FieldName  string   `mapstructure:"field_name"`
+				OptionID   string   `mapstructure:"option_id"`
+				OptionName string   `mapstructure:"option_name"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.ItemIDs) == 0 {
+				return mcp.NewToolResultError("item_ids must contain at least one item ID"), nil
+			}
+			if (params.OptionID == "") == (params.OptionName == "") {
+				return mcp.NewToolResultError("exactly one of option_id or option_name must be provided"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			field, err := resolveProjectStatusField(ctx, client, params.ProjectID, params.FieldID, params.FieldName)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			raw := params.OptionID
+			if raw == "" {
+				raw = params.OptionName
+			}
+			option, err := coerceProjectFieldValue(field, raw)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			updates := make([]projectStatusUpdate, 0, len(params.ItemIDs))
+			for _, itemID := range params.ItemIDs {
+				updates = append(updates, projectStatusUpdate{ItemID: itemID, Option: option})
+			}
+			response := applyProjectStatusUpdates(ctx, client, params.ProjectID, field, updates)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Move items to different statuses in one call (e.g., replay a triage session)
+// EXPECTS: project_id, status_map (item ID → option name or ID), field_id or field_name
+// RETURNS: Items updated and items that failed with their error
+// INTEGRATION: Every option in the map is resolved before any item is touched, so one typo fails the whole call
+func ApplyProjectStatusMap(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_APPLY_PROJECT_STATUS_MAP_DESCRIPTION", "Set a single-select value (e.g., Status) on several GitHub Projects v2 items, each to its own option, from a map of item ID to option name or ID. All options are resolved before any item is updated.")),
+		mcp.WithToolAnnotation(mcp.ToolAnnotation{
+			Title:        t("TOOL_APPLY_PROJECT_STATUS_MAP_USER_TITLE", "Apply project status map"),
+			ReadOnlyHint: ToBoolPtr(false),
+		}),
+		mcp.WithString("project_id",
+			mcp.Required(),
+			mcp.Description("GitHub Projects v2 project ID"),
+		),
+		mcp.WithObject("status_map",
+			mcp.Required(),
+			mcp.Description("Map of project item ID (PVTI_xxxx format) to the option name or option ID to set (e.g., {\"PVTI_1\": \"Done\", \"PVTI_2\": \"In Progress\"})"),
+		),
+	}
+	options = append(options, withStatusFieldParams()...)
+
+	return mcp.NewTool("apply_project_status_map", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string            `mapstructure:"project_id"`
+				StatusMap map[string]string `mapstructure:"status_map"`
+				FieldID   string            `mapstructure:"field_id"`
+				FieldName string            `mapstructure:"field_name"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.StatusMap) == 0 {
+				return mcp.NewToolResultError("status_map must contain at least one item"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			field, err := resolveProjectStatusField(ctx, client, params.ProjectID, params.FieldID, params.FieldName)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			// VERIFIED: Map iteration order is random, so items are applied in sorted ID order for stable output
+			itemIDs := make([]string, 0, len(params.StatusMap))
+			for itemID := range params.StatusMap {
+				itemIDs = append(itemIDs, itemID)
+			}
+			sort.Strings(itemIDs)
+
+			updates := make([]projectStatusUpdate, 0, len(itemIDs))
+			for _, itemID := range itemIDs {
+				option, err := coerceProjectFieldValue(field, params.StatusMap[itemID])
+				if err != nil {
+					return mcp.NewToolResultError(fmt.Sprintf("item %s: %v", itemID, err)), nil
+				}
+				updates = append(updates, projectStatusUpdate{ItemID: strings.TrimSpace(itemID), Option: option})
+			}
+			response := applyProjectStatusUpdates(ctx, client, params.ProjectID, field, updates)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Pause or resume a built-in project workflow (e.g., auto-add while bulk importing)
+// EXPECTS: project_id, workflow_id, enabled
+// RETURNS: The workflow's state, or a note when the change has to be made in the project settings
+// INTEGRATION: The GraphQL API can read and delete workflows but has no mutation to toggle them
+func SetProjectWorkflowEnabled(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_project_workflow_enabled",
+			mcp.WithDescription(t("TOOL_SET_PROJECT_WORKFLOW_ENABLED_DESCRIPTION", "Enable or disable a built-in GitHub Projects v2 workflow (e.g., auto-add). The GitHub API does not currently allow toggling workflows, so when the workflow is not already in the requested state this returns a note with a link to the project's workflow settings instead of changing it.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_PROJECT_WORKFLOW_ENABLED_USER_TITLE", "Enable or disable project workflow"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("workflow_id",
+				mcp.Required(),
+				mcp.Description("Project workflow ID (PWF_xxxx format)"),
+			),
+			mcp.WithBoolean("enabled",
+				mcp.Required(),
+				mcp.Description("Whether the workflow should be enabled"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID  string `mapstructure:"project_id"`
+				WorkflowID string `mapstructure:"workflow_id"`
+				Enabled    bool   `mapstructure:"enabled"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var query struct {
+				Node struct {
+					ProjectV2Workflow struct {
+						ID      githubv4.ID
+						Name    githubv4.String
+						Number  githubv4.Int
+						Enabled githubv4.Boolean
+						Project struct {
+							ID  githubv4.ID
+							URL githubv4.String `graphql:"url"`
+						}
+					} `graphql:"... on ProjectV2Workflow"`
+				} `graphql:"node(id: $id)"`
+			}
+			if err := client.Query(ctx, &query, map[string]interface{}{
+				"id": githubv4.ID(params.WorkflowID),
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get workflow", err), nil
+			}
+			workflow := query.Node.ProjectV2Workflow
+			if workflow.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("workflow %s not found or is not a project workflow", params.WorkflowID)), nil
+			}
+			if fmt.Sprint(workflow.Project.ID) != params.ProjectID {
+				return mcp.NewToolResultError(fmt.Sprintf("workflow %s does not belong to project %s", params.WorkflowID, params.ProjectID)), nil
+			}
+
+			response := map[string]interface{}{
+				"workflow_id": workflow.ID,
+				"name":        workflow.Name,
+				"number":      workflow.Number,
+				"enabled":     bool(workflow.Enabled),
+				"changed":     false,
+			}
+			// VERIFIED: The schema only has deleteProjectV2Workflow; enabling or disabling is a settings-page action
+			if bool(workflow.Enabled) == params.Enabled {
+				response["supported"] = true
+				response["message"] = fmt.Sprintf("Workflow %q is already %s", workflow.Name, enabledLabel(params.Enabled))
+			} else {
+				response["supported"] = false
+				response["settings_url"] = fmt.Sprintf("%s/workflows", workflow.Project.URL)
+				response["note"] = fmt.Sprintf("The GitHub API does not support enabling or disabling project workflows. Workflow %q is still %s; change it in the project's workflow settings.", workflow.Name, enabledLabel(bool(workflow.Enabled)))
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// enabledLabel describes a workflow's enabled state for messages.
+func enabledLabel(enabled bool) string {
+	if enabled {
+		return "enabled"
+	}
+	return "disabled"
+}
+
+// UNDERSTANDING: Per-person load for capacity planning
+// EXPECTS: project_id, optional exclude_status (e.g., 'Done'), status_field_name and max_items
+// RETURNS: Item counts per assignee login, busiest first, with unassigned items under "(unassigned)"
+// INTEGRATION: An item with several assignees counts once for each of them
+func GetProjectAssigneeWorkload(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_assignee_workload",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ASSIGNEE_WORKLOAD_DESCRIPTION", "Count the items on a GitHub Projects v2 board per assignee login, busiest first. Items without an assignee are counted under \"(unassigned)\". Use exclude_status to leave out finished work (e.g., 'Done').")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ASSIGNEE_WORKLOAD_USER_TITLE", "Get project assignee workload"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("exclude_status",
+				mcp.Description("Optional status option name whose items are not counted (e.g., 'Done')"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Name of the single-select status field (default: 'Status')"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string `mapstructure:"project_id"`
+				ExcludeStatus   string `mapstructure:"exclude_status"`
+				StatusFieldName string `mapstructure:"status_field_name"`
+				MaxItems        int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			counts := map[string]int{}
+			counted := 0
+			for _, item := range fetched.Items {
+				if item.Orphaned {
+					continue
+				}
+				if params.ExcludeStatus != "" && strings.EqualFold(item.singleSelectName(params.StatusFieldName), params.ExcludeStatus) {
+					continue
+				}
+				counted++
+				if len(item.Assignees) == 0 {
+					counts[unassignedBucket]++
+					continue
+				}
+				for _, login := range item.Assignees {
+					counts[login]++
+				}
+			}
+
+			workload := make([]map[string]interface{}, 0, len(counts))
+			logins := make([]string, 0, len(counts))
+			for login := range counts {
+				logins = append(logins, login)
+			}
+			sort.Slice(logins, func(i, j int) bool {
+				if counts[logins[i]] != counts[logins[j]] {
+					return counts[logins[i]] > counts[logins[j]]
+				}
+				return logins[i] < logins[j]
+			})
+			for _, login := range logins {
+				workload = append(workload, map[string]interface{}{
+					"assignee":   login,
+					"item_count": counts[login],
+				})
+			}
+
+			response := map[string]interface{}{
+				"project_id":    params.ProjectID,
+				"counted_items": counted,
+				"workload":      workload,
+			}
+			if params.ExcludeStatus != "" {
+				response["excluded_status"] = params.ExcludeStatus
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// defaultMaxFieldOptions caps how many options get_project_fields lists per single-select field.
+const defaultMaxFieldOptions = 25
+
+// projectOptionsOutput prepares a single-select field's options, with colors and descriptions, for a tool response.
+func projectOptionsOutput(options []projectSingleSelectOption) []map[string]interface{} {
+	output := make([]map[string]interface{}, 0, len(options))
+	for _, option := range options {
+		output = append(output, map[string]interface{}{
+			"id":          option.ID,
+			"name":        option.Name,
+			"color":       option.Color,
+			"description": option.Description,
+		})
+	}
+	return output
+}
+
+// projectIterationsOutput prepares an iteration field's iterations for a tool response.
+func projectIterationsOutput(iterations []projectIterationNode) []map[string]interface{} {
+	output := make([]map[string]interface{}, 0, len(iterations))
+	for _, iteration := range iterations {
+		output = append(output, map[string]interface{}{
+			"id":         iteration.ID,
+			"title":      iteration.Title,
+			"start_date": iteration.StartDate,
+			"duration":   iteration.Duration,
+		})
+	}
+	return output
+}
+
+// UNDERSTANDING: Discover field IDs and option values before updating items
+// EXPECTS: project_id
+// RETURNS: Every field with its ID, name and data type, plus options for single-select fields and iterations for iteration fields
+// INTEGRATION: Feeds field_id and value into update_project_item_status and the other item-editing tools
+func GetProjectFields(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_fields",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_FIELDS_DESCRIPTION", "List every field on a GitHub Projects v2 board with its ID, name and data type. Single-select fields include their option IDs and names, and iteration fields include their configured iterations. Use this to find the field_id and value for update_project_item_status.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_FIELDS_USER_TITLE", "Get project fields"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithNumber("max_options",
+				mcp.Description(fmt.Sprintf("Maximum number of options to list per single-select field (default: %d)", defaultMaxFieldOptions)),
+				mcp.Min(1),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID  string `mapstructure:"project_id"`
+				MaxOptions int    `mapstructure:"max_options"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.MaxOptions <= 0 {
+				params.MaxOptions = defaultMaxFieldOptions
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fields, err := fetchProjectFields(ctx, client, params.ProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+			}
+
+			output := make([]map[string]interface{}, 0, len(fields))
+			var capped []string
+			for _, field := range fields {
+				entry := map[string]interface{}{
+					"id":        field.ID,
+					"name":      field.Name,
+					"data_type": field.DataType,
+				}
+				switch field.DataType {
+				case "SINGLE_SELECT":
+					// VERIFIED: Only the listing is capped; option lookups by name still see every option of the field
+					shown := field.Options[:min(len(field.Options), params.MaxOptions)]
+					options := make([]map[string]interface{}, 0, len(shown))
+					for _, option := range shown {
+						options = append(options, map[string]interface{}{
+							"id":   option.ID,
+							"name": option.Name,
+						})
+					}
+					entry["options"] = options
+					if len(shown) < len(field.Options) {
+						entry["option_count"] = len(field.Options)
+						entry["options_truncated"] = true
+						capped = append(capped, field.Name)
+					}
+				case "ITERATION":
+					entry["iterations"] = projectIterationsOutput(field.Iterations)
+				}
+				output = append(output, entry)
+			}
+
+			response := map[string]interface{}{
+				"project_id":  params.ProjectID,
+				"field_count": len(output),
+				"fields":      output,
+			}
+			if len(capped) > 0 {
+				response["note"] = fmt.Sprintf("Only the first %d options are listed for %s. Tools that take an option name, such as update_project_items_status, still match against every option.", params.MaxOptions, strings.Join(capped, ", "))
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// issueStateActions are the actions sync_project_item_status_to_issue_state can map a status to.
+var issueStateActions = map[string]bool{"close": true, "reopen": true, "none": true}
+
+// UNDERSTANDING: Keep an issue's open/closed state in line with its board column (e.g., Done means closed)
+// EXPECTS: project_id, item_id, status_actions (status option name → close, reopen or none), optional status_field_name
+// RETURNS: The item's status, the action taken and the issue's state before and after
+// INTEGRATION: Issues already in the target state are left alone, so the tool is safe to call repeatedly
+func SyncProjectItemStatusToIssueState(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("sync_project_item_status_to_issue_state",
+			mcp.WithDescription(t("TOOL_SYNC_PROJECT_ITEM_STATUS_TO_ISSUE_STATE_DESCRIPTION", "Close or reopen the issue behind a GitHub Projects v2 item to match its current status, using a map of status option name to action (close, reopen or none). Statuses missing from the map leave the issue unchanged.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SYNC_PROJECT_ITEM_STATUS_TO_ISSUE_STATE_USER_TITLE", "Sync project item status to issue state"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format) of an issue"),
+			),
+			mcp.WithObject("status_actions",
+				mcp.Required(),
+				mcp.Description("Map of status option name to issue action: 'close', 'reopen' or 'none' (e.g., {\"Done\": \"close\", \"Todo\": \"reopen\"})"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Name of the single-select status field (default: 'Status')"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string            `mapstructure:"project_id"`
+				ItemID          string            `mapstructure:"item_id"`
+				StatusActions   map[string]string `mapstructure:"status_actions"`
+				StatusFieldName string            `mapstructure:"status_field_name"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+			for status, action := range params.StatusActions {
+				if !issueStateActions[strings.ToLower(action)] {
+					return mcp.NewToolResultError(fmt.Sprintf("invalid action %q for status %q: must be close, reopen or none", action, status)), nil
+				}
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			item, err := fetchProjectItem(ctx, client, params.ItemID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+			}
+			if item.Type != "ISSUE" {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s is a %s, not an issue", params.ItemID, item.Type)), nil
+			}
+
+			status := item.singleSelectName(params.StatusFieldName)
+			action := "none"
+			for name, mapped := range params.StatusActions {
+				if status != "" && strings.EqualFold(name, status) {
+					action = strings.ToLower(mapped)
+				}
+			}
+
+			response := map[string]interface{}{
+				"project_id":     params.ProjectID,
+				"item_id":        item.ID,
+				"issue_id":       item.ContentID,
+				"number":         item.Number,
+				"url":            item.URL,
+				"status":         status,
+				"action":         action,
+				"previous_state": item.State,
+				"state":          item.State,
+				"changed":        false,
+			}
+
+			// VERIFIED: Issues already in the target state are skipped rather than sent a redundant mutation
+			switch {
+			case action == "close" && item.State == "OPEN":
+				var closeMutation struct {
+					CloseIssue struct {
+						Issue struct {
+							ID    githubv4.ID
+							State githubv4.IssueState
+						}
+					} `graphql:"closeIssue(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &closeMutation, githubv4.CloseIssueInput{
+					IssueID: githubv4.ID(item.ContentID),
+				}, nil); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to close issue", err), nil
+				}
+				response["state"] = closeMutation.CloseIssue.Issue.State
+				response["changed"] = true
+			case action == "reopen" && item.State == "CLOSED":
+				var reopenMutation struct {
+					ReopenIssue struct {
+						Issue struct {
+							ID    githubv4.ID
+							State githubv4.IssueState
+						}
+					} `graphql:"reopenIssue(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &reopenMutation, githubv4.ReopenIssueInput{
+					IssueID: githubv4.ID(item.ContentID),
+				}, nil); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to reopen issue", err), nil
+				}
+				response["state"] = reopenMutation.ReopenIssue.Issue.State
+				response["changed"] = true
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// repositoryOwnerNode is a repository with the node ID of its owner.
+type repositoryOwnerNode struct {
+	ID            githubv4.ID
+	NameWithOwner githubv4.String
+	Owner         struct {
+		ID    githubv4.ID
+		Login githubv4.String
+	}
+}
+
+// fetchRepositoryOwner looks up a repository by node ID, or by owner and name when no ID is given.
+func fetchRepositoryOwner(ctx context.Context, client *githubv4.Client, repositoryID, owner, repo string) (repositoryOwnerNode, error) {
+	if repositoryID != "" {
+		var query struct {
+			Node struct {
+				Repository repositoryOwnerNode `graphql:"... on Repository"`
+			} `graphql:"node(id: $id)"`
+		}
+		if err := client.Query(ctx, &query, map[string]interface{}{
+			"id": githubv4.ID(repositoryID),
+		}); err != nil {
+			return repositoryOwnerNode{}, err
+		}
+		if query.Node.Repository.ID == nil {
+			return repositoryOwnerNode{}, fmt.Errorf("%s not found or is not a repository", repositoryID)
+		}
+		return query.Node.Repository, nil
+	}
+
+	var query struct {
+		Repository repositoryOwnerNode `graphql:"repository(owner: $owner, name: $repo)"`
+	}
+	if err := client.Query(ctx, &query, map[string]interface{}{
+		"owner": githubv4.String(owner),
+		"repo":  githubv4.String(repo),
+	}); err != nil {
+		return repositoryOwnerNode{}, err
+	}
+	if query.Repository.ID == nil {
+		return repositoryOwnerNode{}, fmt.Errorf("repository %s/%s not found", owner, repo)
+	}
+	return query.Repository, nil
+}
+
+// UNDERSTANDING: Set up a board for a new repository in one step
+// EXPECTS: repository_id or owner and repo, title
+// RETURNS: The new project and confirmation that it is linked to the repository
+// INTEGRATION: The project is owned by the repository's owner, as create_project followed by link_project_to_repository would do
+func CreateProjectForRepository(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("create_project_for_repository",
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_FOR_REPOSITORY_DESCRIPTION", "Create a GitHub Projects v2 board owned by a repository's owner and link it to that repository in one step. Identify the repository by repository_id or by owner and repo.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PROJECT_FOR_REPOSITORY_USER_TITLE", "Create project for repository"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("repository_id",
+				mcp.Description("GitHub node ID of the repository (R_xxxx format). Alternative to owner and repo"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Repository owner, used with repo instead of repository_id"),
+			),
+			mcp.WithString("repo",
+				mcp.Description("Repository name, used with owner instead of repository_id"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title/name for the new project"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				RepositoryID string `mapstructure:"repository_id"`
+				Owner        string `mapstructure:"owner"`
+				Repo         string `mapstructure:"repo"`
+				Title        string `mapstructure:"title"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			byName := params.Owner != "" && params.Repo != ""
+			if (params.RepositoryID == "") == !byName {
+				return mcp.NewToolResultError("provide either repository_id or both owner and repo"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			repository, err := fetchRepositoryOwner(ctx, client, params.RepositoryID, params.Owner, params.Repo)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get repository", err), nil
+			}
+
+			var createProjectMutation struct {
+				CreateProjectV2 struct {
+					ProjectV2 struct {
+						ID     githubv4.ID
+						Number githubv4.Int
+						Title  githubv4.String
+						URL    githubv4.String
+					}
+				} `graphql:"createProjectV2(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &createProjectMutation, githubv4.CreateProjectV2Input{
+				OwnerID: repository.Owner.ID,
+				Title:   githubv4.String(params.Title),
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to create project", err), nil
+			}
+			project := createProjectMutation.CreateProjectV2.ProjectV2
+
+			// VERIFIED: A failed link still reports the new project's ID so it can be linked or deleted by hand
+			var linkProjectMutation struct {
+				LinkProjectV2ToRepository struct {
+					Repository struct {
+						ID githubv4.ID
+					}
+				} `graphql:"linkProjectV2ToRepository(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &linkProjectMutation, githubv4.LinkProjectV2ToRepositoryInput{
+				ProjectID:    project.ID,
+				RepositoryID: repository.ID,
+			}, nil); err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project %v was created but linking it to %s failed: %v", project.ID, repository.NameWithOwner, err)), nil
+			}
+
+			response := map[string]interface{}{
+				"success":        true,
+				"message":        fmt.Sprintf("Project created and linked to %s", repository.NameWithOwner),
+				"project_id":     project.ID,
+				"project_number": int(project.Number),
+				"title":          project.Title,
+				"url":            project.URL,
+				"owner":          repository.Owner.Login,
+				"repository_id":  repository.ID,
+				"repository":     repository.NameWithOwner,
+				"linked":         true,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Show which of many boards the current user can edit (e.g., for a dashboard)
+// EXPECTS: project_ids
+// RETURNS: Each project with the viewer's permission (write, read or none) and the underlying viewerCan* flags
+// INTEGRATION: All projects are looked up in a single nodes(ids:) request rather than one query per board
+func GetProjectsViewerPermissions(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_projects_viewer_permissions",
+			mcp.WithDescription(t("TOOL_GET_PROJECTS_VIEWER_PERMISSIONS_DESCRIPTION", "Get the current user's permission on several GitHub Projects v2 boards in one request. Each project is reported as write (can edit), read (can view only) or none (not found or not accessible).")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECTS_VIEWER_PERMISSIONS_USER_TITLE", "Get viewer permissions for projects"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithArray("project_ids",
+				mcp.Required(),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+				mcp.Description("GitHub Projects v2 project IDs to check"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectIDs []string `mapstructure:"project_ids"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.ProjectIDs) == 0 {
+				return mcp.NewToolResultError("project_ids must contain at least one project ID"), nil
+			}
+			if len(params.ProjectIDs) > 100 {
+				return mcp.NewToolResultError("project_ids can contain at most 100 project IDs"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// VERIFIED: nodes(ids:) returns results in request order, with null for IDs that are missing or inaccessible
+			var query struct {
+				Nodes []struct {
+					ProjectV2 struct {
+						ID              githubv4.ID
+						Title           githubv4.String
+						URL             githubv4.String
+						ViewerCanUpdate githubv4.Boolean
+						ViewerCanClose  githubv4.Boolean
+						ViewerCanReopen githubv4.Boolean
+					} `graphql:"... on ProjectV2"`
+				} `graphql:"nodes(ids: $ids)"`
+			}
+			ids := make([]githubv4.ID, 0, len(params.ProjectIDs))
+			for _, id := range params.ProjectIDs {
+				ids = append(ids, githubv4.ID(id))
+			}
+			if err := client.Query(ctx, &query, map[string]interface{}{
+				"ids": ids,
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get projects", err), nil
+			}
+
+			projects := make([]map[string]interface{}, 0, len(params.ProjectIDs))
+			editable := 0
+			for i, projectID := range params.ProjectIDs {
+				entry := map[string]interface{}{
+					"project_id": projectID,
+					"permission": "none",
+				}
+				if i < len(query.Nodes) && query.Nodes[i].ProjectV2.ID != nil {
+					project := query.Nodes[i].ProjectV2
+					entry["title"] = project.Title
+					entry["url"] = project.URL
+					entry["viewer_can_update"] = bool(project.ViewerCanUpdate)
+					entry["viewer_can_close"] = bool(project.ViewerCanClose)
+					entry["viewer_can_reopen"] = bool(project.ViewerCanReopen)
+					entry["permission"] = "read"
+					if project.ViewerCanUpdate {
+						entry["permission"] = "write"
+						editable++
+					}
+				} else {
+					entry["error"] = "project not found or not accessible"
+				}
+				projects = append(projects, entry)
+			}
+
+			response := map[string]interface{}{
+				"projects":       projects,
+				"editable_count": editable,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Spot work that has not been picked up yet (e.g., "In Progress" issues nobody has opened a PR for)
+// EXPECTS: project_id
+// RETURNS: Issue items with no linked pull request, optionally limited to one status
+// INTEGRATION: Linked means listed under the issue's Development section (closedByPullRequestsReferences), including closed PRs
+func FindProjectItemsWithoutPR(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("find_project_items_without_pr",
+			mcp.WithDescription(t("TOOL_FIND_PROJECT_ITEMS_WITHOUT_PR_DESCRIPTION", "Find issues on a GitHub Projects v2 board that have no linked pull request. A pull request counts as linked when it will close the issue, as shown in the issue's Development section. Draft issues and pull requests are skipped. Use status to check a single column (e.g., 'In Progress').")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FIND_PROJECT_ITEMS_WITHOUT_PR_USER_TITLE", "Find project items without a pull request"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("status",
+				mcp.Description("Optional status option name to limit the search to (e.g., 'In Progress')"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Name of the single-select status field (default: 'Status')"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string `mapstructure:"project_id"`
+				Status          string `mapstructure:"status"`
+				StatusFieldName string `mapstructure:"status_field_name"`
+				MaxItems        int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			items := make([]map[string]interface{}, 0)
+			checked := 0
+			for _, item := range fetched.Items {
+				if item.Orphaned || item.Type != "ISSUE" || item.LinkedPullRequestCount == nil {
+					continue
+				}
+				status := item.singleSelectName(params.StatusFieldName)
+				if params.Status != "" && !strings.EqualFold(status, params.Status) {
+					continue
+				}
+				checked++
+				if *item.LinkedPullRequestCount > 0 {
+					continue
+				}
+				entry := map[string]interface{}{
+					"id":         item.ID,
+					"content_id": item.ContentID,
+					"number":     item.Number,
+					"title":      item.Title,
+					"url":        item.URL,
+					"state":      item.State,
+					"repository": item.Repository,
+					"assignees":  item.Assignees,
+				}
+				if status != "" {
+					entry["status"] = status
+				}
+				items = append(items, entry)
+			}
+
+			response := map[string]interface{}{
+				"project_id":     params.ProjectID,
+				"checked_issues": checked,
+				"items":          items,
+				"count":          len(items),
+			}
+			if params.Status != "" {
+				response["status"] = params.Status
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Unset a field value, e.g. move an item back to "No Status" or drop it from a sprint
+// EXPECTS: project_id, item_id, field_id
+// RETURNS: Success confirmation with the cleared field's name and type
+// INTEGRATION: Built-in fields such as Title, Assignees and Labels belong to the issue, not the item, and are rejected
+func ClearProjectItemField(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("clear_project_item_field",
+			mcp.WithDescription(t("TOOL_CLEAR_PROJECT_ITEM_FIELD_DESCRIPTION", "Clear a field value on a GitHub Projects v2 item, leaving it empty. Works for text, number, date, single-select (e.g., move an item back to \"No Status\") and iteration fields. To remove assignees, use set_project_items_assignees.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CLEAR_PROJECT_ITEM_FIELD_USER_TITLE", "Clear project item field"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID"),
+			),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Project field ID to clear (use get_project_fields to find this)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				ItemID    string `mapstructure:"item_id"`
+				FieldID   string `mapstructure:"field_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			field, err := fetchProjectField(ctx, client, params.FieldID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project field", err), nil
+			}
+			// VERIFIED: clearProjectV2ItemFieldValue only accepts the field types users can create
+			if !customFieldTypes[field.DataType] {
+				return mcp.NewToolResultError(fmt.Sprintf("field %q is a %s field, which cannot be cleared; only text, number, date, single-select and iteration fields can", field.Name, field.DataType)), nil
+			}
+
+			var clearMutation struct {
+				ClearProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"clearProjectV2ItemFieldValue(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &clearMutation, githubv4.ClearProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				ItemID:    githubv4.ID(params.ItemID),
+				FieldID:   githubv4.ID(field.ID),
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to clear project item field", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":    true,
+				"message":    "Project item field cleared successfully",
+				"item_id":    clearMutation.ClearProjectV2ItemFieldValue.ProjectV2Item.ID,
+				"field":      field.Name,
+				"field_type": field.DataType,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Turn every planned draft into a real issue once the plan is agreed
+// EXPECTS: project_id, repository_id
+// RETURNS: Each draft with the issue number it became, plus any drafts that failed to convert
+// INTEGRATION: Drafts are converted one at a time with convertProjectV2DraftIssueItemToIssue, so a failure does not stop the rest
+func ConvertAllDraftsToIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_all_drafts_to_issues",
+			mcp.WithDescription(t("TOOL_CONVERT_ALL_DRAFTS_TO_ISSUES_DESCRIPTION", "Convert every draft issue on a GitHub Projects v2 board into an issue in the given repository. Items keep their place and field values on the board. Drafts that fail to convert are reported without stopping the others.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CONVERT_ALL_DRAFTS_TO_ISSUES_USER_TITLE", "Convert all project drafts to issues"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("repository_id",
+				mcp.Required(),
+				mcp.Description("GitHub repository node ID (R_xxxx format) to create the issues in"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID    string `mapstructure:"project_id"`
+				RepositoryID string `mapstructure:"repository_id"`
+				MaxItems     int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			convertedItems := []map[string]interface{}{}
+			failed := []map[string]interface{}{}
+			for _, item := range fetched.Items {
+				if item.Type != "DRAFT_ISSUE" {
+					continue
+				}
+				converted, err := convertDraftIssue(ctx, client, item.ID, params.RepositoryID)
+				if err != nil {
+					failed = append(failed, map[string]interface{}{"item_id": item.ID, "title": item.Title, "error": err.Error()})
+					continue
+				}
+				convertedItems = append(convertedItems, map[string]interface{}{
+					"item_id":      item.ID,
+					"title":        item.Title,
+					"content_id":   converted.Content.Issue.ID,
+					"issue_number": int(converted.Content.Issue.Number),
+					"url":          converted.Content.Issue.URL,
+				})
+			}
+
+			response := map[string]interface{}{
+				"success":         len(failed) == 0,
+				"message":         fmt.Sprintf("Converted %d of %d draft issue(s)", len(convertedItems), len(convertedItems)+len(failed)),
+				"project_id":      params.ProjectID,
+				"repository_id":   params.RepositoryID,
+				"converted":       convertedItems,
+				"converted_count": len(convertedItems),
+				"failed":          failed,
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Sprint burndown - how many points are left in one iteration right now
+// EXPECTS: project_id, field_id (iteration field), iteration_id, field_name (number field holding points), done_status
+// RETURNS: Total, completed and remaining points and item counts for the iteration
+// INTEGRATION: A snapshot of the current board; call it daily to chart remaining points over time
+func GetProjectIterationBurndown(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_iteration_burndown",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ITERATION_BURNDOWN_DESCRIPTION", "Get burndown data for one iteration (sprint) on a GitHub Projects v2 board: the total points of the items in the iteration, how many are done and how many remain. Points are read from a number field; items without points count as 0 and are reported as unestimated.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ITERATION_BURNDOWN_USER_TITLE", "Get project iteration burndown"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Iteration field ID (use get_project_fields to find this)"),
+			),
+			mcp.WithString("iteration_id",
+				mcp.Required(),
+				mcp.Description("ID of the iteration to report on (listed under the field's iterations in get_project_fields)"),
+			),
+			mcp.WithString("field_name",
+				mcp.Required(),
+				mcp.Description("Name of the number field holding each item's points (e.g., 'Estimate')"),
+			),
+			mcp.WithString("done_status",
+				mcp.Required(),
+				mcp.Description("Status option name that marks an item as done (e.g., 'Done')"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Name of the single-select status field (default: 'Status')"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string `mapstructure:"project_id"`
+				FieldID         string `mapstructure:"field_id"`
+				IterationID     string `mapstructure:"iteration_id"`
+				FieldName       string `mapstructure:"field_name"`
+				DoneStatus      string `mapstructure:"done_status"`
+				StatusFieldName string `mapstructure:"status_field_name"`
+				MaxItems        int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			var iterationTitle string
+			var total, completed float64
+			itemCount, doneCount, unestimated := 0, 0, 0
+			for _, item := range fetched.Items {
+				iteration, ok := item.fieldValueByID(params.FieldID)
+				if !ok || iteration.IterationID != params.IterationID {
+					continue
+				}
+				iterationTitle, _ = iteration.Value.(string)
+				itemCount++
+
+				points := 0.0
+				if value, ok := item.fieldValue(params.FieldName); ok {
+					points, _ = value.Value.(float64)
+				} else {
+					unestimated++
+				}
+				total += points
+				if strings.EqualFold(item.singleSelectName(params.StatusFieldName), params.DoneStatus) {
+					doneCount++
+					completed += points
+				}
+			}
+
+			response := map[string]interface{}{
+				"project_id":        params.ProjectID,
+				"iteration_id":      params.IterationID,
+				"points_field":      params.FieldName,
+				"done_status":       params.DoneStatus,
+				"item_count":        itemCount,
+				"done_item_count":   doneCount,
+				"total_points":      roundTo2(total),
+				"completed_points":  roundTo2(completed),
+				"remaining_points":  roundTo2(total - completed),
+				"unestimated_items": unestimated,
+			}
+			if iterationTitle != "" {
+				response["iteration_title"] = iterationTitle
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// projectMetadata is the board-level settings of a project as returned by update_project.
+type projectMetadata struct {
+	ID               githubv4.ID
+	Number           githubv4.Int
+	Title            githubv4.String
+	URL              githubv4.String
+	ShortDescription githubv4.String
+	Readme           githubv4.String
+	Public           githubv4.Boolean
+	Closed           githubv4.Boolean
+}
+
+// UNDERSTANDING: Rename a board, change its description or README, make it public/private, or close/reopen it
+// EXPECTS: project_id, any of title, short_description, readme, public, closed
+// RETURNS: The project's metadata after the update and which fields were changed
+// INTEGRATION: Only the provided fields are sent to updateProjectV2; with none the current metadata is returned unchanged
+func UpdateProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_DESCRIPTION", "Update a GitHub Projects v2 board's title, short description, README, visibility (public or private) or closed state. Only the fields that are provided are changed; calling it with none just returns the current settings.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UPDATE_PROJECT_USER_TITLE", "Update project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("title",
+				mcp.Description("New project title"),
+			),
+			mcp.WithString("short_description",
+				mcp.Description("New short description"),
+			),
+			mcp.WithString("readme",
+				mcp.Description("New README (markdown)"),
+			),
+			mcp.WithBoolean("public",
+				mcp.Description("true to make the project public, false to make it private"),
+			),
+			mcp.WithBoolean("closed",
+				mcp.Description("true to close the project, false to reopen it"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID        string  `mapstructure:"project_id"`
+				Title            *string `mapstructure:"title"`
+				ShortDescription *string `mapstructure:"short_description"`
+				Readme           *string `mapstructure:"readme"`
+				Public           *bool   `mapstructure:"public"`
+				Closed           *bool   `mapstructure:"closed"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.Title != nil && strings.TrimSpace(*params.Title) == "" {
+				return mcp.NewToolResultError("title cannot be empty"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			input := githubv4.UpdateProjectV2Input{ProjectID: githubv4.ID(params.ProjectID)}
+			updated := []string{}
+			if params.Title != nil {
+				input.Title = githubv4.NewString(githubv4.String(*params.Title))
+				updated = append(updated, "title")
+			}
+			if params.ShortDescription != nil {
+				input.ShortDescription = githubv4.NewString(githubv4.String(*params.ShortDescription))
+				updated = append(updated, "short_description")
+			}
+			if params.Readme != nil {
+				input.Readme = githubv4.NewString(githubv4.String(*params.Readme))
+				updated = append(updated, "readme")
+			}
+			if params.Public != nil {
+				input.Public = githubv4.NewBoolean(githubv4.Boolean(*params.Public))
+				updated = append(updated, "public")
+			}
+			if params.Closed != nil {
+				input.Closed = githubv4.NewBoolean(githubv4.Boolean(*params.Closed))
+				updated = append(updated, "closed")
+			}
+
+			// VERIFIED: updateProjectV2 with only a project ID would be a pointless write, so read the project instead
+			var project projectMetadata
+			if len(updated) == 0 {
+				var projectQuery struct {
+					Node struct {
+						ProjectV2 projectMetadata `graphql:"... on ProjectV2"`
+					} `graphql:"node(id: $id)"`
+				}
+				if err := client.Query(ctx, &projectQuery, map[string]interface{}{
+					"id": githubv4.ID(params.ProjectID),
+				}); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to get project", err), nil
+				}
+				project = projectQuery.Node.ProjectV2
+				if project.ID == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("project %s not found", params.ProjectID)), nil
+				}
+			} else {
+				var updateProjectMutation struct {
+					UpdateProjectV2 struct {
+						ProjectV2 projectMetadata
+					} `graphql:"updateProjectV2(input: $input)"`
+				}
+				if err := client.Mutate(ctx, &updateProjectMutation, input, nil); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to update project", err), nil
+				}
+				project = updateProjectMutation.UpdateProjectV2.ProjectV2
+			}
+
+			message := "Project updated successfully"
+			if len(updated) == 0 {
+				message = "No fields provided; project left unchanged"
+			}
+			response := map[string]interface{}{
+				"success":           true,
+				"message":           message,
+				"updated_fields":    updated,
+				"project_id":        project.ID,
+				"project_number":    int(project.Number),
+				"title":             project.Title,
+				"url":               project.URL,
+				"short_description": project.ShortDescription,
+				"readme":            project.Readme,
+				"public":            project.Public,
+				"closed":            project.Closed,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Inspect one field without listing the whole board's fields
+// EXPECTS: field_id
+// RETURNS: The field's name and data type, every option of a single-select field and the iterations of an iteration field
+// INTEGRATION: Single-field counterpart to get_project_fields; options are never capped here
+func GetProjectField(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_field",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_FIELD_DESCRIPTION", "Get the full definition of a single GitHub Projects v2 field by its ID: name and data type, plus every option (with color and description) of a single-select field or the configured iterations of an iteration field.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_FIELD_USER_TITLE", "Get project field"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Project field ID (use get_project_fields to find this)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				FieldID string `mapstructure:"field_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			field, err := fetchProjectField(ctx, client, params.FieldID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project field", err), nil
+			}
+
+			response := map[string]interface{}{
+				"id":        field.ID,
+				"name":      field.Name,
+				"data_type": field.DataType,
+			}
+			switch field.DataType {
+			case "SINGLE_SELECT":
+				response["options"] = projectOptionsOutput(field.Options)
+				response["option_count"] = len(field.Options)
+			case "ITERATION":
+				response["iterations"] = projectIterationsOutput(field.Iterations)
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Compare two boards' fields before standardizing them (e.g., team boards drifting from a template)
+// EXPECTS: project_id_a, project_id_b
+// RETURNS: Fields only on A, fields only on B, and common fields whose type or single-select options differ
+// INTEGRATION: Fields are matched by name ignoring case; copy_project_field_options can then align differing options
+func DiffProjectSchemas(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("diff_project_schemas",
+			mcp.WithDescription(t("TOOL_DIFF_PROJECT_SCHEMAS_DESCRIPTION", "Compare the fields of two GitHub Projects v2 boards. Returns the fields that exist only on the first board, only on the second, and fields on both whose data type or single-select options differ. Fields are matched by name, ignoring case.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_DIFF_PROJECT_SCHEMAS_USER_TITLE", "Diff project schemas"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id_a",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID of the first board"),
+			),
+			mcp.WithString("project_id_b",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID of the second board"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectIDA string `mapstructure:"project_id_a"`
+				ProjectIDB string `mapstructure:"project_id_b"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fieldsA, err := fetchProjectFields(ctx, client, params.ProjectIDA)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get fields of project %s: %v", params.ProjectIDA, err)), nil
+			}
+			fieldsB, err := fetchProjectFields(ctx, client, params.ProjectIDB)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get fields of project %s: %v", params.ProjectIDB, err)), nil
+			}
+
+			onlyInA := []map[string]interface{}{}
+			differing := []map[string]interface{}{}
+			identical := 0
+			for _, a := range fieldsA {
+				b, ok := findProjectField(fieldsB, a.Name)
+				if !ok {
+					onlyInA = append(onlyInA, map[string]interface{}{"name": a.Name, "data_type": a.DataType})
+					continue
+				}
+				diff := map[string]interface{}{"name": a.Name}
+				if a.DataType != b.DataType {
+					diff["data_type_a"] = a.DataType
+					diff["data_type_b"] = b.DataType
+				} else {
+					diff["data_type"] = a.DataType
+				}
+				if a.DataType == "SINGLE_SELECT" && b.DataType == "SINGLE_SELECT" {
+					if missing := missingOptionNames(a.Options, b.Options); len(missing) > 0 {
+						diff["options_only_in_a"] = missing
+					}
+					if missing := missingOptionNames(b.Options, a.Options); len(missing) > 0 {
+						diff["options_only_in_b"] = missing
+					}
+				}
+				if len(diff) == 2 {
+					identical++
+					continue
+				}
+				differing = append(differing, diff)
+			}
+			onlyInB := []map[string]interface{}{}
+			for _, b := range fieldsB {
+				if _, ok := findProjectField(fieldsA, b.Name); !ok {
+					onlyInB = append(onlyInB, map[string]interface{}{"name": b.Name, "data_type": b.DataType})
+				}
+			}
+
+			response := map[string]interface{}{
+				"project_id_a":    params.ProjectIDA,
+				"project_id_b":    params.ProjectIDB,
+				"only_in_a":       onlyInA,
+				"only_in_b":       onlyInB,
+				"differing":       differing,
+				"identical_count": identical,
+				"schemas_match":   len(onlyInA) == 0 && len(onlyInB) == 0 && len(differing) == 0,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// missingOptionNames returns the names of options in from that have no option of the same name (ignoring case) in other.
+func missingOptionNames(from, other []projectSingleSelectOption) []string {
+	missing := []string{}
+	for _, option := range from {
+		found := false
+		for _, candidate := range other {
+			if strings.EqualFold(string(candidate.Name), string(option.Name)) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, string(option.Name))
+		}
+	}
+	return missing
+}
+
+// UNDERSTANDING: Jot an idea directly on a board before it becomes a repository issue
+// EXPECTS: project_id, title, optional body
+// RETURNS: The new draft item's ID, ready for field updates or later conversion to an issue
+// INTEGRATION: Complements add_item_to_project for content that does not exist yet; convert_all_drafts_to_issues promotes drafts later
+func AddDraftIssue(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("add_draft_issue",
+			mcp.WithDescription(t("TOOL_ADD_DRAFT_ISSUE_DESCRIPTION", "Add a draft issue to a GitHub Projects v2 board. Drafts live only on the board until they are converted into a repository issue; use add_item_to_project for existing issues and pull requests.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ADD_DRAFT_ISSUE_USER_TITLE", "Add draft issue to project"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("title",
+				mcp.Required(),
+				mcp.Description("Title of the draft issue"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Optional markdown body of the draft issue"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string  `mapstructure:"project_id"`
+				Title     string  `mapstructure:"title"`
+				Body      *string `mapstructure:"body"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var addDraftMutation struct {
+				AddProjectV2DraftIssue struct {
+					ProjectItem struct {
+						ID githubv4.ID
+					}
+				} `graphql:"addProjectV2DraftIssue(input: $input)"`
+			}
+			input := githubv4.AddProjectV2DraftIssueInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				Title:     githubv4.String(params.Title),
+			}
+			if params.Body != nil {
+				input.Body = githubv4.NewString(githubv4.String(*params.Body))
+			}
+			if err := client.Mutate(ctx, &addDraftMutation, input, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to add draft issue", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":    true,
+				"message":    fmt.Sprintf("Draft issue %q added to project", params.Title),
+				"project_id": params.ProjectID,
+				"item_id":    addDraftMutation.AddProjectV2DraftIssue.ProjectItem.ID,
+				"title":      params.Title,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Promote a single draft item to a real repository issue once it is ready to be worked
+// EXPECTS: item_id (a draft issue item), repository_id
+// RETURNS: The new issue's node ID, number and URL; the project item keeps its ID and field values
+// INTEGRATION: Single-item counterpart of convert_all_drafts_to_issues; non-draft items are rejected before the mutation
+func ConvertDraftIssueToIssue(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("convert_draft_issue_to_issue",
+			mcp.WithDescription(t("TOOL_CONVERT_DRAFT_ISSUE_TO_ISSUE_DESCRIPTION", "Convert a draft issue on a GitHub Projects v2 board into an issue in the given repository. The project item keeps its ID and field values. Fails if the item is not a draft issue.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CONVERT_DRAFT_ISSUE_TO_ISSUE_USER_TITLE", "Convert draft issue to issue"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format) of a draft issue"),
+			),
+			mcp.WithString("repository_id",
+				mcp.Required(),
+				mcp.Description("GitHub repository node ID (R_xxxx format) to create the issue in"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ItemID       string `mapstructure:"item_id"`
+				RepositoryID string `mapstructure:"repository_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			// UNDERSTANDING: The mutation's own error for a non-draft item is vague, so the item type is checked first
+			item, err := fetchProjectItem(ctx, client, params.ItemID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+			}
+			if item.Type != "DRAFT_ISSUE" {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s is a %s, not a draft issue; only draft issues can be converted", item.ID, item.Type)), nil
+			}
+
+			converted, err := convertDraftIssue(ctx, client, params.ItemID, params.RepositoryID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to convert draft issue", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":      true,
+				"message":      fmt.Sprintf("Draft issue converted to %s#%d", converted.Content.Issue.Repository.NameWithOwner, converted.Content.Issue.Number),
+				"item_id":      converted.ID,
+				"issue_id":     converted.Content.Issue.ID,
+				"issue_number": int(converted.Content.Issue.Number),
+				"url":          converted.Content.Issue.URL,
+				"repository":   converted.Content.Issue.Repository.NameWithOwner,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Surface upcoming deadlines from a date field for reminders
+// EXPECTS: project_id, field_name (a DATE field such as "Due"), days (window length), optional max_items
+// RETURNS: Items whose date falls between today and today+days (inclusive), soonest first, with days until due
+// INTEGRATION: "Today" is the current UTC date, matching how date field values are stored
+func ListProjectItemsDueSoon(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_items_due_soon",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_ITEMS_DUE_SOON_DESCRIPTION", "List items on a GitHub Projects v2 board whose date field (e.g., 'Due') falls within the next N days, including today, sorted by date. Items without a date, or whose date has already passed, are not included.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_ITEMS_DUE_SOON_USER_TITLE", "List project items due soon"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("field_name",
+				mcp.Required(),
+				mcp.Description("Name of the date field holding the deadline (e.g., 'Due')"),
+			),
+			mcp.WithNumber("days",
+				mcp.Required(),
+				mcp.Description("Length of the window in days; 0 lists only items due today"),
+				mcp.Min(0),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				FieldName string `mapstructure:"field_name"`
+				Days      int    `mapstructure:"days"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.Days < 0 {
+				return mcp.NewToolResultError("days must not be negative"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			fields, err := fetchProjectFields(ctx, client, params.ProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+			}
+			field, ok := findProjectField(fields, params.FieldName)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("field %q not found in project", params.FieldName)), nil
+			}
+			if field.DataType != "DATE" {
+				return mcp.NewToolResultError(fmt.Sprintf("field %q is a %s field, not a date field", field.Name, field.DataType)), nil
+			}
+
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			now := time.Now().UTC()
+			today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+			until := today.AddDate(0, 0, params.Days)
+
+			type dueItem struct {
+				item projectItem
+				due  time.Time
+			}
+			var due []dueItem
+			for _, item := range fetched.Items {
+				if item.Orphaned {
+					continue
+				}
+				value, ok := item.fieldValueByID(field.ID)
+				if !ok {
+					continue
+				}
+				date, err := time.Parse("2006-01-02", fmt.Sprint(value.Value))
+				if err != nil || date.Before(today) || date.After(until) {
+					continue
+				}
+				due = append(due, dueItem{item: item, due: date})
+			}
+			sort.SliceStable(due, func(i, j int) bool {
+				return due[i].due.Before(due[j].due)
+			})
+
+			items := make([]map[string]interface{}, 0, len(due))
+			for _, d := range due {
+				items = append(items, map[string]interface{}{
+					"id":             d.item.ID,
+					"type":           d.item.Type,
+					"content_id":     d.item.ContentID,
+					"number":         d.item.Number,
+					"title":          d.item.Title,
+					"url":            d.item.URL,
+					"state":          d.item.State,
+					"assignees":      d.item.Assignees,
+					"due_date":       d.due.Format("2006-01-02"),
+					"days_until_due": int(d.due.Sub(today).Hours() / 24),
+				})
+			}
+
+			response := map[string]interface{}{
+				"project_id": params.ProjectID,
+				"field_name": field.Name,
+				"from":       today.Format("2006-01-02"),
+				"until":      until.Format("2006-01-02"),
+				"items":      items,
+				"count":      len(items),
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Hide completed items from a board's views without losing them
+// EXPECTS: project_id, item_id
+// RETURNS: Success confirmation with the archived item ID
+// INTEGRATION: Archived items keep their field values; unarchive_project_item restores them
+func ArchiveProjectItem(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("archive_project_item",
+			mcp.WithDescription(t("TOOL_ARCHIVE_PROJECT_ITEM_DESCRIPTION", "Archive an item on a GitHub Projects v2 board. Archived items are hidden from the board's views but keep their field values and can be restored with unarchive_project_item.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ARCHIVE_PROJECT_ITEM_USER_TITLE", "Archive project item"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				ItemID    string `mapstructure:"item_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var archiveMutation struct {
+				ArchiveProjectV2Item struct {
+					Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"archiveProjectV2Item(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &archiveMutation, githubv4.ArchiveProjectV2ItemInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				ItemID:    githubv4.ID(params.ItemID),
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to archive project item", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":    true,
+				"message":    "Item archived",
+				"project_id": params.ProjectID,
+				"item_id":    archiveMutation.ArchiveProjectV2Item.Item.ID,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Bring an archived item back onto the board
+// EXPECTS: project_id, item_id (of an archived item)
+// RETURNS: Success confirmation with the restored item ID
+// INTEGRATION: Counterpart of archive_project_item; the item reappears with its previous field values
+func UnarchiveProjectItem(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("unarchive_project_item",
+			mcp.WithDescription(t("TOOL_UNARCHIVE_PROJECT_ITEM_DESCRIPTION", "Restore an archived item on a GitHub Projects v2 board so it shows up in the board's views again.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_UNARCHIVE_PROJECT_ITEM_USER_TITLE", "Unarchive project item"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format) of an archived item"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				ItemID    string `mapstructure:"item_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var unarchiveMutation struct {
+				UnarchiveProjectV2Item struct {
+					Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"unarchiveProjectV2Item(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &unarchiveMutation, githubv4.UnarchiveProjectV2ItemInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				ItemID:    githubv4.ID(params.ItemID),
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to unarchive project item", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":    true,
+				"message":    "Item restored from the archive",
+				"project_id": params.ProjectID,
+				"item_id":    unarchiveMutation.UnarchiveProjectV2Item.Item.ID,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Take an item added by mistake off a board
+// EXPECTS: project_id, item_id
+// RETURNS: The deleted item ID
+// INTEGRATION: Only the project item is removed; the underlying issue or pull request is untouched. Draft issues exist
+// only on the board, so deleting a draft item discards it
+func DeleteProjectItem(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_project_item",
+			mcp.WithDescription(t("TOOL_DELETE_PROJECT_ITEM_DESCRIPTION", "Remove an item from a GitHub Projects v2 board. This only takes the item off the board and drops its project field values; the underlying issue or pull request is NOT deleted or closed and stays in its repository. A draft issue exists only on the board, so removing it discards the draft. To hide an item while keeping it, use archive_project_item instead.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_PROJECT_ITEM_USER_TITLE", "Remove item from project"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format), not the issue or pull request ID"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				ItemID    string `mapstructure:"item_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var deleteMutation struct {
+				DeleteProjectV2Item struct {
+					DeletedItemID githubv4.ID `graphql:"deletedItemId"`
+				} `graphql:"deleteProjectV2Item(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &deleteMutation, githubv4.DeleteProjectV2ItemInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				ItemID:    githubv4.ID(params.ItemID),
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to delete project item", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":         true,
+				"message":         "Item removed from the project; its issue or pull request was not deleted",
+				"project_id":      params.ProjectID,
+				"deleted_item_id": deleteMutation.DeleteProjectV2Item.DeletedItemID,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// maxProjectBatchSize is the most items a bulk tool processes in one chunk.
+const maxProjectBatchSize = 100
+
+// maxAutoChunkItems caps how many items a bulk tool accepts when splitting them into chunks.
+const maxAutoChunkItems = 1000
+
+// maxProjectChunkPause caps the pause between chunks after repeated rate limits.
+const maxProjectChunkPause = time.Minute
+
+// projectChunkPause is the pause between chunks of a bulk tool; it doubles after a chunk that hit a rate limit.
+// It is a variable so tests can run without waiting.
+var projectChunkPause = time.Second
+
+// mentionsRateLimit reports whether an error message is GitHub's primary or secondary rate limit error.
+func mentionsRateLimit(message string) bool {
+	return strings.Contains(strings.ToLower(message), "rate limit")
+}
+
+// sleepWithContext waits for d, returning the context's error if it is cancelled first.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// withAutoChunkParams adds the auto_chunk and max_batch_size parameters shared by the bulk add tools.
+func withAutoChunkParams() []mcp.ToolOption {
+	return []mcp.ToolOption{
+		mcp.WithBoolean("auto_chunk",
+			mcp.Description("Split more than max_batch_size items into chunks processed one after another, pausing between chunks and backing off when GitHub reports a rate limit"),
+		),
+		mcp.WithNumber("max_batch_size",
+			mcp.Description(fmt.Sprintf("Items per chunk when auto_chunk is set (default: %d)", maxProjectBatchSize)),
+			mcp.Min(1),
+			mcp.Max(maxProjectBatchSize),
+		),
+	}
+}
+
+// projectChunkSize checks a batch of count entries against the auto_chunk settings and returns the chunk size.
+// Without auto_chunk the whole batch, at most maxProjectBatchSize entries, is a single chunk.
+func projectChunkSize(count int, autoChunk bool, maxBatchSize int, param, noun string) (int, error) {
+	if maxBatchSize == 0 {
+		maxBatchSize = maxProjectBatchSize
+	}
+	if maxBatchSize < 1 || maxBatchSize > maxProjectBatchSize {
+		return 0, fmt.Errorf("max_batch_size must be between 1 and %d", maxProjectBatchSize)
+	}
+	switch {
+	case autoChunk && count > maxAutoChunkItems:
+		return 0, fmt.Errorf("%s can contain at most %d %s, even with auto_chunk", param, maxAutoChunkItems, noun)
+	case !autoChunk && count > maxProjectBatchSize:
+		return 0, fmt.Errorf("%s can contain at most %d %s; set auto_chunk to split larger batches", param, maxProjectBatchSize, noun)
+	case !autoChunk:
+		return count, nil
+	}
+	return maxBatchSize, nil
+}
+
+// projectChunkRun is the outcome of runProjectChunks.
+type projectChunkRun struct {
+	Chunks       int
+	StoppedEarly bool
+}
+
+// runProjectChunks calls process with the [start, end) range of each chunk in turn. It pauses projectChunkPause
+// between chunks, doubling the pause up to maxProjectChunkPause after a chunk that process reports as rate limited,
+// and stops early if the context is cancelled while waiting.
+func runProjectChunks(ctx context.Context, count, chunkSize int, process func(start, end int) (rateLimited bool)) projectChunkRun {
+	var run projectChunkRun
+	pause := projectChunkPause
+	for start := 0; start < count; start += chunkSize {
+		if start > 0 {
+			if err := sleepWithContext(ctx, pause); err != nil {
+				run.StoppedEarly = true
+				return run
+			}
+		}
+		run.Chunks++
+		if process(start, min(start+chunkSize, count)) {
+			pause = min(2*pause, maxProjectChunkPause)
+		} else {
+			pause = projectChunkPause
+		}
+	}
+	return run
+}
+
+// UNDERSTANDING: Import a categorized backlog with each item landing in its column
+// EXPECTS: project_id, items [{issue_url, status}], optional status_field_name (default "Status"), optional auto_chunk
+// and max_batch_size to split batches over 100 items
+// RETURNS: One result per item with its item_id and status, or the error that stopped it
+// INTEGRATION: Each status is validated and each URL resolved before that item is added, so an item with a bad status
+// or URL is never added; a failed status write after the add is reported with the item_id so it can be retried
+func AddItemsToProjectWithStatus(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_ADD_ITEMS_TO_PROJECT_WITH_STATUS_DESCRIPTION", "Add several issues or pull requests to a GitHub Projects v2 board, placing each in its own status column. Each item's status is checked before the item is added, so an item with an unknown status is skipped rather than added without one. Returns a result per item; one failing item does not stop the others. Set auto_chunk to add more than 100 items in chunks.")),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
-			Title:        t("TOOL_CREATE_PROJECT_USER_TITLE", "Create project"),
+			Title:        t("TOOL_ADD_ITEMS_TO_PROJECT_WITH_STATUS_USER_TITLE", "Add items to project with status"),
 			ReadOnlyHint: ToBoolPtr(false),
 		}),
-		mcp.WithString("owner_id",
+		mcp.WithString("project_id",
 			mcp.Required(),
-			mcp.Description("GitHub node ID of the user or organization who will own the project (use get_me to find your user ID)"),
+			mcp.Description("GitHub Projects v2 project ID"),
+		),
+		mcp.WithString("status_field_name",
+			mcp.Description("Name of the single-select status field (default: 'Status')"),
 		),
-		mcp.WithString("title",
+		mcp.WithArray("items",
 			mcp.Required(),
-			mcp.Description("Title/name for the new project"),
+			mcp.Items(
+				map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": false,
+					"required":             []string{"issue_url", "status"},
+					"properties": map[string]interface{}{
+						"issue_url": map[string]interface{}{
+							"type":        "string",
+							"description": "Full GitHub URL of the issue or pull request, or its node ID (I_xxxx or PR_xxxx)",
+						},
+						"status": map[string]interface{}{
+							"type":        "string",
+							"description": "Status option name or ID to place the item in (e.g., 'Todo')",
+						},
+					},
+				}),
+			mcp.Description("Items to add (at most 100, or 1000 with auto_chunk), each with the status column it belongs in"),
+		),
+	}
+	options = append(options, withAutoChunkParams()...)
+
+	return mcp.NewTool("add_items_to_project_with_status", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string `mapstructure:"project_id"`
+				StatusFieldName string `mapstructure:"status_field_name"`
+				Items           []struct {
+					IssueURL string `mapstructure:"issue_url"`
+					Status   string `mapstructure:"status"`
+				} `mapstructure:"items"`
+				AutoChunk    bool `mapstructure:"auto_chunk"`
+				MaxBatchSize int  `mapstructure:"max_batch_size"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.Items) == 0 {
+				return mcp.NewToolResultError("items must contain at least one item"), nil
+			}
+			chunkSize, err := projectChunkSize(len(params.Items), params.AutoChunk, params.MaxBatchSize, "items", "items")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			statusField, err := fetchProjectStatusField(ctx, client, params.ProjectID, params.StatusFieldName)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			var addItemMutation struct {
+				AddProjectV2ItemById struct {
+					Item struct {
+						ID         githubv4.ID
+						DatabaseID githubv4.Int
+					}
+				} `graphql:"addProjectV2ItemById(input: $input)"`
+			}
+			var updateFieldMutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+
+			results := make([]map[string]interface{}, 0, len(params.Items))
+			added, failed := 0, 0
+			run := runProjectChunks(ctx, len(params.Items), chunkSize, func(start, end int) bool {
+				rateLimited := false
+				for _, entry := range params.Items[start:end] {
+					result := map[string]interface{}{"issue_url": entry.IssueURL}
+					fail := func(message string) {
+						result["success"] = false
+						result["error"] = message
+						results = append(results, result)
+						failed++
+						rateLimited = rateLimited || mentionsRateLimit(message)
+					}
+
+					status, err := coerceProjectFieldValue(statusField, entry.Status)
+					if err != nil {
+						fail(err.Error())
+						continue
+					}
+
+					contentID := strings.TrimSpace(entry.IssueURL)
+					if strings.Contains(contentID, "/") {
+						content, err := resolveProjectContent(ctx, client, contentID)
+						if err != nil {
+							fail(fmt.Sprintf("failed to resolve issue_url: %v", err))
+							continue
+						}
+						contentID = content.ContentID
+					}
+					result["content_id"] = contentID
+
+					if err := client.Mutate(ctx, &addItemMutation, githubv4.AddProjectV2ItemByIdInput{
+						ProjectID: githubv4.ID(params.ProjectID),
+						ContentID: githubv4.ID(contentID),
+					}, nil); err != nil {
+						fail(fmt.Sprintf("failed to add item to project: %v", err))
+						continue
+					}
+					itemID := addItemMutation.AddProjectV2ItemById.Item.ID
+					result["item_id"] = itemID
+
+					if err := client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+						ProjectID: githubv4.ID(params.ProjectID),
+						ItemID:    itemID,
+						FieldID:   githubv4.ID(statusField.ID),
+						Value:     status.Input,
+					}, nil); err != nil {
+						fail(fmt.Sprintf("item was added to the project but setting %s failed: %v", statusField.Name, err))
+						continue
+					}
+
+					result["success"] = true
+					result["status"] = status.Value
+					result["option_id"] = status.OptionID
+					results = append(results, result)
+					added++
+				}
+				return rateLimited
+			})
+
+			response := map[string]interface{}{
+				"success":      failed == 0,
+				"message":      fmt.Sprintf("%d of %d items added with %s set", added, len(params.Items), statusField.Name),
+				"project_id":   params.ProjectID,
+				"status_field": statusField.Name,
+				"results":      results,
+				"added_count":  added,
+				"failed_count": failed,
+			}
+			if params.AutoChunk {
+				response["chunk_count"] = run.Chunks
+			}
+			if run.StoppedEarly {
+				response["success"] = false
+				response["stopped_early"] = true
+				response["remaining_count"] = len(params.Items) - len(results)
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// projectOptionColors are the colors GitHub accepts for single-select options.
+var projectOptionColors = []string{"GRAY", "BLUE", "GREEN", "YELLOW", "ORANGE", "RED", "PINK", "PURPLE"}
+
+// singleSelectOptionsSchema describes an array of {name, color, description} option objects.
+func singleSelectOptionsSchema() map[string]interface{} {
+	return map[string]interface{}{
+		"type":                 "object",
+		"additionalProperties": false,
+		"required":             []string{"name"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "Option name",
+			},
+			"color": map[string]interface{}{
+				"type":        "string",
+				"enum":        projectOptionColors,
+				"description": "Option color (default: GRAY)",
+			},
+			"description": map[string]interface{}{
+				"type":        "string",
+				"description": "Optional option description",
+			},
+		},
+	}
+}
+
+// singleSelectOptionParam is an option as passed to the field tools. ID is only set for an existing option
+// matched by update_project_field, so the option keeps its identity and item values.
+type singleSelectOptionParam struct {
+	ID          string `mapstructure:"-"`
+	Name        string `mapstructure:"name"`
+	Color       string `mapstructure:"color"`
+	Description string `mapstructure:"description"`
+}
+
+// singleSelectOptionInputs validates option params and converts them to mutation inputs. Colors default to GRAY.
+func singleSelectOptionInputs(options []singleSelectOptionParam) ([]ProjectV2SingleSelectFieldOptionInput, error) {
+	inputs := make([]ProjectV2SingleSelectFieldOptionInput, 0, len(options))
+	seen := map[string]bool{}
+	for _, option := range options {
+		name := strings.TrimSpace(option.Name)
+		if name == "" {
+			return nil, fmt.Errorf("option names must not be empty")
+		}
+		if seen[strings.ToLower(name)] {
+			return nil, fmt.Errorf("option %q is listed more than once", name)
+		}
+		seen[strings.ToLower(name)] = true
+
+		color := strings.ToUpper(strings.TrimSpace(option.Color))
+		if color == "" {
+			color = "GRAY"
+		}
+		if !slices.Contains(projectOptionColors, color) {
+			return nil, fmt.Errorf("option %q has unknown color %q; expected one of %s", name, option.Color, strings.Join(projectOptionColors, ", "))
+		}
+		input := ProjectV2SingleSelectFieldOptionInput{
+			Name:        githubv4.String(name),
+			Color:       githubv4.ProjectV2SingleSelectFieldOptionColor(color),
+			Description: githubv4.String(option.Description),
+		}
+		if option.ID != "" {
+			input.ID = githubv4.NewString(githubv4.String(option.ID))
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+// UNDERSTANDING: Set up a board's custom fields (priority, effort, ...) without the UI
+// EXPECTS: project_id, name, data_type (TEXT, NUMBER, DATE, SINGLE_SELECT), options for single-select fields
+// RETURNS: The new field's ID and, for single-select fields, the created options with their IDs
+// INTEGRATION: Iteration fields need a cycle configuration and are not supported; the field cache is dropped so name lookups see the new field
+func CreateProjectField(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("create_project_field",
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_FIELD_DESCRIPTION", "Create a custom field on a GitHub Projects v2 board. Supports text, number, date and single-select fields; single-select fields need at least one option, each with a name and optional color and description. Returns the new field ID and the IDs of any created options.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PROJECT_FIELD_USER_TITLE", "Create project field"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("name",
+				mcp.Required(),
+				mcp.Description("Name of the new field (e.g., 'Priority')"),
+			),
+			mcp.WithString("data_type",
+				mcp.Required(),
+				mcp.Description("Type of the new field"),
+				mcp.Enum("TEXT", "NUMBER", "DATE", "SINGLE_SELECT"),
+			),
+			mcp.WithArray("options",
+				mcp.Items(singleSelectOptionsSchema()),
+				mcp.Description("Options of a SINGLE_SELECT field, in display order. Not allowed for other types"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string                    `mapstructure:"project_id"`
+				Name      string                    `mapstructure:"name"`
+				DataType  string                    `mapstructure:"data_type"`
+				Options   []singleSelectOptionParam `mapstructure:"options"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			params.DataType = strings.ToUpper(params.DataType)
+
+			input := githubv4.CreateProjectV2FieldInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				DataType:  githubv4.ProjectV2CustomFieldType(params.DataType),
+				Name:      githubv4.String(params.Name),
+			}
+			switch params.DataType {
+			case "TEXT", "NUMBER", "DATE":
+				if len(params.Options) > 0 {
+					return mcp.NewToolResultError(fmt.Sprintf("options can only be given for SINGLE_SELECT fields, not %s", params.DataType)), nil
+				}
+			case "SINGLE_SELECT":
+				if len(params.Options) == 0 {
+					return mcp.NewToolResultError("a SINGLE_SELECT field needs at least one option"), nil
+				}
+				options, err := singleSelectOptionInputs(params.Options)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				createOptions := newSingleSelectOptionInputs(options)
+				input.SingleSelectOptions = &createOptions
+			default:
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported data_type %q: expected TEXT, NUMBER, DATE or SINGLE_SELECT", params.DataType)), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var createFieldMutation struct {
+				CreateProjectV2Field struct {
+					ProjectV2Field projectFieldNode
+				} `graphql:"createProjectV2Field(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &createFieldMutation, input, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to create project field", err), nil
+			}
+			field := newProjectField(createFieldMutation.CreateProjectV2Field.ProjectV2Field)
+			projectFieldDefinitions.invalidate(params.ProjectID)
+
+			response := map[string]interface{}{
+				"success":    true,
+				"message":    fmt.Sprintf("Field %s created", field.Name),
+				"project_id": params.ProjectID,
+				"field_id":   field.ID,
+				"name":       field.Name,
+				"data_type":  field.DataType,
+			}
+			if field.DataType == "SINGLE_SELECT" {
+				response["options"] = projectOptionsOutput(field.Options)
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// actorLogin is the login of a user, bot or mannequin; deleted accounts are null.
+type actorLogin struct {
+	Login githubv4.String
+}
+
+// timelineActorEvent is a timeline event with its actor.
+type timelineActorEvent struct {
+	Actor     *actorLogin
+	CreatedAt githubv4.DateTime
+}
+
+// contentTimelineNode is the last actor-bearing event on an issue or pull request timeline.
+type contentTimelineNode struct {
+	Typename     githubv4.String `graphql:"__typename"`
+	IssueComment struct {
+		Author    *actorLogin
+		CreatedAt githubv4.DateTime
+	} `graphql:"... on IssueComment"`
+	LabeledEvent      timelineActorEvent `graphql:"... on LabeledEvent"`
+	UnlabeledEvent    timelineActorEvent `graphql:"... on UnlabeledEvent"`
+	AssignedEvent     timelineActorEvent `graphql:"... on AssignedEvent"`
+	UnassignedEvent   timelineActorEvent `graphql:"... on UnassignedEvent"`
+	ClosedEvent       timelineActorEvent `graphql:"... on ClosedEvent"`
+	ReopenedEvent     timelineActorEvent `graphql:"... on ReopenedEvent"`
+	RenamedTitleEvent timelineActorEvent `graphql:"... on RenamedTitleEvent"`
+}
+
+// actor returns the login and time of the event, or false when its actor is unknown (e.g., a deleted account).
+func (n contentTimelineNode) actor() (string, time.Time, bool) {
+	var event timelineActorEvent
+	switch n.Typename {
+	case "IssueComment":
+		event = timelineActorEvent{Actor: n.IssueComment.Author, CreatedAt: n.IssueComment.CreatedAt}
+	case "LabeledEvent":
+		event = n.LabeledEvent
+	case "UnlabeledEvent":
+		event = n.UnlabeledEvent
+	case "AssignedEvent":
+		event = n.AssignedEvent
+	case "UnassignedEvent":
+		event = n.UnassignedEvent
+	case "ClosedEvent":
+		event = n.ClosedEvent
+	case "ReopenedEvent":
+		event = n.ReopenedEvent
+	case "RenamedTitleEvent":
+		event = n.RenamedTitleEvent
+	}
+	if event.Actor == nil || event.Actor.Login == "" {
+		return "", time.Time{}, false
+	}
+	return string(event.Actor.Login), event.CreatedAt.Time, true
+}
+
+// projectItemActivityQuery selects who set each of an item's field values and the last event on its content.
+// Only timeline events that record who acted are requested, so the last node carries an actor.
+type projectItemActivityQuery struct {
+	Node struct {
+		ProjectV2Item struct {
+			ID          githubv4.ID
+			Creator     *actorLogin
+			CreatedAt   githubv4.DateTime
+			FieldValues struct {
+				Nodes []struct {
+					Common struct {
+						Creator   *actorLogin
+						UpdatedAt githubv4.DateTime
+						Field     projectFieldRef
+					} `graphql:"... on ProjectV2ItemFieldValueCommon"`
+				}
+			} `graphql:"fieldValues(first: 100)"`
+			Content *struct {
+				Issue struct {
+					TimelineItems struct {
+						Nodes []contentTimelineNode
+					} `graphql:"timelineItems(last: 1, itemTypes: [ISSUE_COMMENT, LABELED_EVENT, UNLABELED_EVENT, ASSIGNED_EVENT, UNASSIGNED_EVENT, CLOSED_EVENT, REOPENED_EVENT, RENAMED_TITLE_EVENT])"`
+				} `graphql:"... on Issue"`
+				PullRequest struct {
+					TimelineItems struct {
+						Nodes []contentTimelineNode
+					} `graphql:"timelineItems(last: 1, itemTypes: [ISSUE_COMMENT, LABELED_EVENT, UNLABELED_EVENT, ASSIGNED_EVENT, UNASSIGNED_EVENT, CLOSED_EVENT, REOPENED_EVENT, RENAMED_TITLE_EVENT])"`
+				} `graphql:"... on PullRequest"`
+			}
+		} `graphql:"... on ProjectV2Item"`
+	} `graphql:"node(id: $id)"`
+}
+
+// fetchProjectItemLastActor works out who last touched an item. GitHub records who set each field value, so the
+// most recently updated value wins; without any, the last actor-bearing event on the issue or pull request is used,
+// and finally whoever added the item. The map is nil when no actor is known.
+func fetchProjectItemLastActor(ctx context.Context, client *githubv4.Client, itemID string) (map[string]interface{}, error) {
+	var query projectItemActivityQuery
+	if err := client.Query(ctx, &query, map[string]interface{}{
+		"id": githubv4.ID(itemID),
+	}); err != nil {
+		return nil, err
+	}
+	item := query.Node.ProjectV2Item
+
+	var latest map[string]interface{}
+	var latestAt time.Time
+	for _, value := range item.FieldValues.Nodes {
+		if value.Common.Creator == nil || value.Common.Creator.Login == "" || !value.Common.UpdatedAt.After(latestAt) {
+			continue
+		}
+		latestAt = value.Common.UpdatedAt.Time
+		latest = map[string]interface{}{
+			"login":      string(value.Common.Creator.Login),
+			"at":         latestAt,
+			"source":     "field_value",
+			"field_name": string(value.Common.Field.Common.Name),
+		}
+	}
+	if latest != nil {
+		return latest, nil
+	}
+
+	if item.Content != nil {
+		nodes := item.Content.Issue.TimelineItems.Nodes
+		if len(nodes) == 0 {
+			nodes = item.Content.PullRequest.TimelineItems.Nodes
+		}
+		for _, node := range nodes {
+			if login, at, ok := node.actor(); ok {
+				return map[string]interface{}{
+					"login":  login,
+					"at":     at,
+					"source": "content_timeline",
+					"event":  string(node.Typename),
+				}, nil
+			}
+		}
+	}
+
+	if item.Creator != nil && item.Creator.Login != "" {
+		return map[string]interface{}{
+			"login":  string(item.Creator.Login),
+			"at":     item.CreatedAt.Time,
+			"source": "item_creator",
+		}, nil
+	}
+	return nil, nil
+}
+
+// UNDERSTANDING: Look up one board item, including who last touched it for audit trails
+// EXPECTS: item_id
+// RETURNS: The item with its content and field values, plus last_updated_by {login, at, source}
+// INTEGRATION: The Projects API has no item history; see fetchProjectItemLastActor for how the actor is derived
+func GetProjectItem(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_item",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ITEM_DESCRIPTION", "Get a single GitHub Projects v2 item with its content and field values, plus last_updated_by: the person who most recently set one of its field values. When no field value records an actor, the last commenter or event actor on the linked issue or pull request is used instead, and finally whoever added the item; source says which.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ITEM_USER_TITLE", "Get project item"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ItemID string `mapstructure:"item_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			item, err := fetchProjectItem(ctx, client, params.ItemID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+			}
+			lastActor, err := fetchProjectItemLastActor(ctx, client, params.ItemID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item activity", err), nil
+			}
+
+			response := map[string]interface{}{
+				"item":            item,
+				"last_updated_by": lastActor,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// updateSingleSelectOptionsSchema extends singleSelectOptionsSchema with the ID of the existing option being edited.
+func updateSingleSelectOptionsSchema() map[string]interface{} {
+	schema := singleSelectOptionsSchema()
+	properties := schema["properties"].(map[string]interface{})
+	properties["id"] = map[string]interface{}{
+		"type":        "string",
+		"description": "ID of the existing option to rename or recolor; omit to match by name or create a new option",
+	}
+	properties["color"] = map[string]interface{}{
+		"type":        "string",
+		"enum":        projectOptionColors,
+		"description": "Option color (default: the existing option's color, or GRAY for a new option)",
+	}
+	properties["description"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Option description (default: the existing option's description)",
+	}
+	return schema
+}
+
+// updateSingleSelectOptionParam is an option of the desired option set passed to update_project_field.
+type updateSingleSelectOptionParam struct {
+	ID          string  `mapstructure:"id"`
+	Name        string  `mapstructure:"name"`
+	Color       *string `mapstructure:"color"`
+	Description *string `mapstructure:"description"`
+}
+
+// projectOptionRename records an option whose name changed.
+type projectOptionRename struct {
+	ID   string `json:"id"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// singleSelectOptionsDiff is the difference between a field's options and a desired option set.
+type singleSelectOptionsDiff struct {
+	Options []singleSelectOptionParam
+	Created []string
+	Renamed []projectOptionRename
+	Deleted []string
+}
+
+// diffSingleSelectOptions matches the desired options against the existing ones, by ID when given and by
+// name (case-insensitively) otherwise. Matched options keep their color and description unless overridden.
+func diffSingleSelectOptions(existing []projectSingleSelectOption, desired []updateSingleSelectOptionParam) (singleSelectOptionsDiff, error) {
+	diff := singleSelectOptionsDiff{
+		Options: make([]singleSelectOptionParam, 0, len(desired)),
+		Created: []string{},
+		Renamed: []projectOptionRename{},
+		Deleted: []string{},
+	}
+	matched := map[string]bool{}
+	for _, option := range desired {
+		name := strings.TrimSpace(option.Name)
+		var current *projectSingleSelectOption
+		for i := range existing {
+			if option.ID != "" && string(existing[i].ID) == option.ID ||
+				option.ID == "" && strings.EqualFold(string(existing[i].Name), name) {
+				current = &existing[i]
+				break
+			}
+		}
+		if option.ID != "" && current == nil {
+			return singleSelectOptionsDiff{}, fmt.Errorf("option ID %s does not belong to this field", option.ID)
+		}
+
+		param := singleSelectOptionParam{Name: name}
+		if current != nil {
+			if matched[string(current.ID)] {
+				return singleSelectOptionsDiff{}, fmt.Errorf("option %q is listed more than once", current.Name)
+			}
+			matched[string(current.ID)] = true
+			param.ID = string(current.ID)
+			param.Color = string(current.Color)
+			param.Description = string(current.Description)
+			if name != string(current.Name) {
+				diff.Renamed = append(diff.Renamed, projectOptionRename{ID: string(current.ID), From: string(current.Name), To: name})
+			}
+		} else {
+			diff.Created = append(diff.Created, name)
+		}
+		if option.Color != nil {
+			param.Color = *option.Color
+		}
+		if option.Description != nil {
+			param.Description = *option.Description
+		}
+		diff.Options = append(diff.Options, param)
+	}
+	for _, option := range existing {
+		if !matched[string(option.ID)] {
+			diff.Deleted = append(diff.Deleted, string(option.Name))
+		}
+	}
+	return diff, nil
+}
+
+// UNDERSTANDING: Edit a field's name and, for single-select fields, its options in one call
+// EXPECTS: field_id, and a new name and/or the complete desired option list
+// RETURNS: The updated field definition plus the options created, renamed and deleted
+// INTEGRATION: updateProjectV2Field replaces the whole option set, so unlisted options are deleted and the rest re-sent with their IDs
+func UpdateProjectField(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("update_project_field",
+			mcp.WithDescription(t("TOOL_UPDATE_PROJECT_FIELD_DESCRIPTION", "Update a field on a GitHub Projects v2 board: rename it and, for single-select fields, create, rename, recolor or delete options. GitHub replaces the whole option set on every update, so options must list every option the field should keep, in display order; any existing option left out is deleted and items lose that value. Existing options are matched by id, or by name when no id is given.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_UPDATE_PROJECT_FIELD_USER_TITLE", "Update project field"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Project field ID to update"),
+			),
+			mcp.WithString("name",
+				mcp.Description("New name for the field (default: unchanged)"),
+			),
+			mcp.WithArray("options",
+				mcp.Items(updateSingleSelectOptionsSchema()),
+				mcp.Description("Complete option set of a SINGLE_SELECT field, in display order. Options not listed are deleted (default: unchanged)"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				FieldID string                          `mapstructure:"field_id"`
+				Name    *string                         `mapstructure:"name"`
+				Options []updateSingleSelectOptionParam `mapstructure:"options"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.Name == nil && params.Options == nil {
+				return mcp.NewToolResultError("nothing to update: provide name and/or options"), nil
+			}
+			if params.Name != nil && strings.TrimSpace(*params.Name) == "" {
+				return mcp.NewToolResultError("name must not be empty"), nil
+			}
+			if params.Options != nil && len(params.Options) == 0 {
+				return mcp.NewToolResultError("a SINGLE_SELECT field needs at least one option"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			input := UpdateProjectV2FieldInput{FieldID: githubv4.ID(params.FieldID)}
+			if params.Name != nil {
+				input.Name = githubv4.NewString(githubv4.String(strings.TrimSpace(*params.Name)))
+			}
+
+			var diff singleSelectOptionsDiff
+			if params.Options != nil {
+				field, err := fetchProjectField(ctx, client, params.FieldID)
+				if err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to get field", err), nil
+				}
+				if field.DataType != "SINGLE_SELECT" {
+					return mcp.NewToolResultError(fmt.Sprintf("options can only be given for SINGLE_SELECT fields, not %s", field.DataType)), nil
+				}
+				diff, err = diffSingleSelectOptions(field.Options, params.Options)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				options, err := singleSelectOptionInputs(diff.Options)
+				if err != nil {
+					return mcp.NewToolResultError(err.Error()), nil
+				}
+				input.SingleSelectOptions = &options
+			}
+
+			var updateFieldMutation struct {
+				UpdateProjectV2Field struct {
+					ProjectV2Field projectFieldNode
+				} `graphql:"updateProjectV2Field(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &updateFieldMutation, input, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to update project field", err), nil
+			}
+			field := newProjectField(updateFieldMutation.UpdateProjectV2Field.ProjectV2Field)
+			projectFieldDefinitions.reset()
+
+			response := map[string]interface{}{
+				"success":   true,
+				"message":   fmt.Sprintf("Field %s updated", field.Name),
+				"field_id":  field.ID,
+				"name":      field.Name,
+				"data_type": field.DataType,
+			}
+			if field.DataType == "SINGLE_SELECT" {
+				response["options"] = projectOptionsOutput(field.Options)
+			}
+			if params.Options != nil {
+				response["created_options"] = diff.Created
+				response["renamed_options"] = diff.Renamed
+				response["deleted_options"] = diff.Deleted
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// UNDERSTANDING: Clean up experimental or obsolete fields without the UI
+// EXPECTS: field_id
+// RETURNS: The deleted field's ID, name and data type
+// INTEGRATION: Built-in fields such as Title or Status cannot be deleted; GitHub rejects those with an error
+func DeleteProjectField(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("delete_project_field",
+			mcp.WithDescription(t("TOOL_DELETE_PROJECT_FIELD_DESCRIPTION", "Delete a custom field from a GitHub Projects v2 board. WARNING: this permanently removes the field's values from every item on the board, and views that group, sort or filter by the field lose that setting. This cannot be undone.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:           t("TOOL_DELETE_PROJECT_FIELD_USER_TITLE", "Delete project field"),
+				ReadOnlyHint:    ToBoolPtr(false),
+				DestructiveHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("Project field ID to delete"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				FieldID string `mapstructure:"field_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var deleteFieldMutation struct {
+				DeleteProjectV2Field struct {
+					ProjectV2Field projectFieldNode
+				} `graphql:"deleteProjectV2Field(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &deleteFieldMutation, githubv4.DeleteProjectV2FieldInput{
+				FieldID: githubv4.ID(params.FieldID),
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to delete project field", err), nil
+			}
+			field := newProjectField(deleteFieldMutation.DeleteProjectV2Field.ProjectV2Field)
+			projectFieldDefinitions.reset()
+
+			response := map[string]interface{}{
+				"success":   true,
+				"message":   fmt.Sprintf("Field %s deleted along with its values on all items", field.Name),
+				"field_id":  field.ID,
+				"name":      field.Name,
+				"data_type": field.DataType,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// repositoryProjectQuery looks up a project by the number shown in a repository's Projects tab.
+type repositoryProjectQuery struct {
+	Repository *struct {
+		ProjectV2 *struct {
+			ID     githubv4.ID
+			Number githubv4.Int
+			Title  githubv4.String
+			URL    githubv4.String
+			Closed githubv4.Boolean
+		} `graphql:"projectV2(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// UNDERSTANDING: Turn the project number users see in a repository's Projects tab into the node ID other tools need
+// EXPECTS: owner, repo, project_number
+// RETURNS: The project's ID, number, title, URL and closed state
+// INTEGRATION: The number is the project's own number under its owner, as shown in its URL, not a position in the tab
+func GetRepositoryProjectID(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_repository_project_id",
+			mcp.WithDescription(t("TOOL_GET_REPOSITORY_PROJECT_ID_DESCRIPTION", "Resolve a GitHub Projects v2 project number, as shown in a repository's Projects tab, to the project node ID used by the other project tools.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_REPOSITORY_PROJECT_ID_USER_TITLE", "Get repository project ID"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("owner",
+				mcp.Required(),
+				mcp.Description("Repository owner"),
+			),
+			mcp.WithString("repo",
+				mcp.Required(),
+				mcp.Description("Repository name"),
+			),
+			mcp.WithNumber("project_number",
+				mcp.Required(),
+				mcp.Description("Project number, as shown in the project's URL (e.g., 5 for .../projects/5)"),
+				mcp.Min(1),
+			),
 		),
-		mcp.WithString("description",
-			mcp.Description("Optional description for the project"),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				Owner         string `mapstructure:"owner"`
+				Repo          string `mapstructure:"repo"`
+				ProjectNumber int    `mapstructure:"project_number"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.ProjectNumber <= 0 {
+				return mcp.NewToolResultError("project_number must be a positive number"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var query repositoryProjectQuery
+			if err := client.Query(ctx, &query, map[string]interface{}{
+				"owner":  githubv4.String(params.Owner),
+				"repo":   githubv4.String(params.Repo),
+				"number": githubv4.Int(params.ProjectNumber), // #nosec G115 - project numbers fit in int32
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get repository project", err), nil
+			}
+			if query.Repository == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("repository %s/%s not found", params.Owner, params.Repo)), nil
+			}
+			project := query.Repository.ProjectV2
+			if project == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project #%d not found for repository %s/%s", params.ProjectNumber, params.Owner, params.Repo)), nil
+			}
+
+			response := map[string]interface{}{
+				"project_id": project.ID,
+				"number":     project.Number,
+				"title":      project.Title,
+				"url":        project.URL,
+				"closed":     project.Closed,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// projectViewsQuery lists a page of a project's saved views.
+type projectViewsQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			ID    githubv4.ID
+			Views struct {
+				Nodes      []projectView
+				TotalCount githubv4.Int
+				PageInfo   struct {
+					HasNextPage githubv4.Boolean
+					EndCursor   githubv4.String
+				}
+			} `graphql:"views(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// fetchProjectViews pages through every saved view of a project.
+func fetchProjectViews(ctx context.Context, client *githubv4.Client, projectID string) ([]projectView, error) {
+	var views []projectView
+	var after *githubv4.String
+	for {
+		var query projectViewsQuery
+		if err := client.Query(ctx, &query, map[string]interface{}{
+			"id":    githubv4.ID(projectID),
+			"first": githubv4.Int(100),
+			"after": after,
+		}); err != nil {
+			return nil, err
+		}
+		views = append(views, query.Node.ProjectV2.Views.Nodes...)
+		pageInfo := query.Node.ProjectV2.Views.PageInfo
+		if !pageInfo.HasNextPage {
+			return views, nil
+		}
+		cursor := pageInfo.EndCursor
+		after = &cursor
+	}
+}
+
+// UNDERSTANDING: Enumerate a board's saved views so automations can point at a specific one
+// EXPECTS: project_id, optional first (default 10, max 100) and after cursor
+// RETURNS: Each view's ID, name, number, layout and filter, with page info
+// INTEGRATION: The view number is the one in the view's URL (.../views/<number>)
+func ListProjectViews(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_views",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_VIEWS_DESCRIPTION", "List the saved views of a GitHub Projects v2 board (board, table and roadmap layouts) with their IDs, names, numbers and filters.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_VIEWS_USER_TITLE", "List project views"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithNumber("first",
+				mcp.Description("Number of views to retrieve (default: 10, max: 100)"),
+			),
+			mcp.WithString("after",
+				mcp.Description("Cursor for the next page: pass the end_cursor of the previous response while has_next_page is true"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				First     *int   `mapstructure:"first"`
+				After     string `mapstructure:"after"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			first := 10
+			if params.First != nil {
+				first = max(1, min(*params.First, 100))
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var after *githubv4.String
+			if params.After != "" {
+				after = githubv4.NewString(githubv4.String(params.After))
+			}
+			var query projectViewsQuery
+			if err := client.Query(ctx, &query, map[string]interface{}{
+				"id":    githubv4.ID(params.ProjectID),
+				"first": githubv4.Int(first), // #nosec G115 - clamped to 100
+				"after": after,
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project views", err), nil
+			}
+			if query.Node.ProjectV2.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project %s not found", params.ProjectID)), nil
+			}
+
+			views := query.Node.ProjectV2.Views
+			if views.Nodes == nil {
+				views.Nodes = []projectView{}
+			}
+			response := map[string]interface{}{
+				"project_id":    params.ProjectID,
+				"views":         views.Nodes,
+				"total_count":   int(views.TotalCount),
+				"has_next_page": bool(views.PageInfo.HasNextPage),
+				"end_cursor":    views.PageInfo.EndCursor,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
+
+// projectDetails is a project's metadata with its item and field counts.
+type projectDetails struct {
+	ID               githubv4.ID
+	Number           githubv4.Int
+	Title            githubv4.String
+	ShortDescription githubv4.String
+	URL              githubv4.String
+	Closed           githubv4.Boolean
+	Public           githubv4.Boolean
+	Items            struct {
+		TotalCount githubv4.Int
+	} `graphql:"items(first: 1)"`
+	Fields struct {
+		TotalCount githubv4.Int
+	} `graphql:"fields(first: 1)"`
+}
+
+// projectByIDQuery looks up a project by node ID.
+type projectByIDQuery struct {
+	Node struct {
+		ProjectV2 projectDetails `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// projectByNumberQuery looks up a project by number under a user or organization node.
+type projectByNumberQuery struct {
+	Node struct {
+		ProjectV2Owner struct {
+			ProjectV2 *projectDetails `graphql:"projectV2(number: $number)"`
+		} `graphql:"... on ProjectV2Owner"`
+	} `graphql:"node(id: $ownerId)"`
+}
+
+// UNDERSTANDING: Fetch one project's metadata directly, e.g. to confirm what create_project or update_project did
+// EXPECTS: project_id, or owner (user or organization login) plus number
+// RETURNS: Title, short description, URL, closed and public flags, and item and field counts
+// INTEGRATION: The owner's node ID comes from the shared owner cache, so users and organizations use one query
+func GetProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_DESCRIPTION", "Get a single GitHub Projects v2 board by node ID, or by owner login and project number. Returns its title, short description, URL, closed and public state, and item and field counts.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_USER_TITLE", "Get project"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Description("GitHub Projects v2 project ID. Provide either this or owner and number"),
+			),
+			mcp.WithString("owner",
+				mcp.Description("Login of the user or organization that owns the project; requires number"),
+			),
+			mcp.WithNumber("number",
+				mcp.Description("Project number, as shown in the project's URL (e.g., 5 for .../projects/5); requires owner"),
+				mcp.Min(1),
+			),
 		),
-	),
-	func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var params struct {
-			OwnerID     string  `mapstructure:"owner_id"`
-			Title       string  `mapstructure:"title"`
-			Description *string `mapstructure:"description"`
-		}
-		if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
-		}
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				Owner     string `mapstructure:"owner"`
+				Number    int    `mapstructure:"number"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			byNumber := params.Owner != "" || params.Number != 0
+			switch {
+			case params.ProjectID != "" && byNumber:
+				return mcp.NewToolResultError("provide either project_id or owner and number, not both"), nil
+			case params.ProjectID == "" && !byNumber:
+				return mcp.NewToolResultError("one of project_id or owner and number must be provided"), nil
+			case byNumber && (params.Owner == "" || params.Number <= 0):
+				return mcp.NewToolResultError("owner and a positive number must be provided together"), nil
+			}
 
-		// UNDERSTANDING: Get GraphQL client following existing patterns
-		// VERIFIED: Same pattern used in AddItemToProject and other GraphQL tools
-		client, err := getGQLClient(ctx)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
-		}
-
-		// UNDERSTANDING: Execute createProjectV2 mutation following GitHub's Projects v2 API
-		// EXPECTS: GitHub node ID for owner, project title, optional description
-		// RETURNS: Complete project details including ID for immediate use with other tools
-		// INTEGRATION: Foundational mutation that enables full project automation workflow
-		var createProjectMutation struct {
-			CreateProjectV2 struct {
-				ProjectV2 struct {
-					ID               githubv4.ID
-					Number           githubv4.Int
-					Title            githubv4.String
-					URL              githubv4.String
-					ShortDescription githubv4.String
-					CreatedAt        githubv4.DateTime
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var project *projectDetails
+			if byNumber {
+				owner, err := resolveOwnerID(ctx, client, params.Owner, "")
+				if err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to get project", err), nil
 				}
-			} `graphql:"createProjectV2(input: $input)"`
-		}
+				var query projectByNumberQuery
+				if err := client.Query(ctx, &query, map[string]interface{}{
+					"ownerId": githubv4.ID(owner.ID),
+					"number":  githubv4.Int(params.Number), // #nosec G115 - project numbers fit in int32
+				}); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to get project", err), nil
+				}
+				project = query.Node.ProjectV2Owner.ProjectV2
+				if project == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("project #%d not found for %s", params.Number, params.Owner)), nil
+				}
+			} else {
+				var query projectByIDQuery
+				if err := client.Query(ctx, &query, map[string]interface{}{
+					"id": githubv4.ID(params.ProjectID),
+				}); err != nil {
+					return projectGraphQLErrorResult(ctx, "failed to get project", err), nil
+				}
+				project = &query.Node.ProjectV2
+				if project.ID == nil {
+					return mcp.NewToolResultError(fmt.Sprintf("project %s not found", params.ProjectID)), nil
+				}
+			}
 
-		// UNDERSTANDING: Prepare input following GitHub's CreateProjectV2Input schema
-		// VERIFIED: Based on GitHub's official API documentation and examples
-		input := githubv4.CreateProjectV2Input{
-			OwnerID: githubv4.ID(params.OwnerID),
-			Title:   githubv4.String(params.Title),
-		}
+			response := map[string]interface{}{
+				"project_id":        project.ID,
+				"number":            project.Number,
+				"title":             project.Title,
+				"short_description": project.ShortDescription,
+				"url":               project.URL,
+				"closed":            project.Closed,
+				"public":            project.Public,
+				"item_count":        int(project.Items.TotalCount),
+				"field_count":       int(project.Fields.TotalCount),
+			}
 
-		// NOTE: ShortDescription field may not be available in current githubv4 version
-		// Will need to check actual field names in the GraphQL schema
-		// TODO: Add description support once field name is confirmed
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
 
-		if err := client.Mutate(
-			ctx,
-			&createProjectMutation,
-			input,
-			nil,
-		); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to create project: %v", err)), nil
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
+
+// UNDERSTANDING: Give agents that cache board state a cheap lookup table from content to project item
+// EXPECTS: project_id, optional max_items
+// RETURNS: Content URL → item ID for issues and pull requests, and draft title → item ID for drafts
+// INTEGRATION: Draft titles need not be unique; the first item keeps the title and later ones are listed as duplicates
+func GetProjectItemIndex(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_item_index",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ITEM_INDEX_DESCRIPTION", "Build a compact lookup table for a GitHub Projects v2 board: issue and pull request URL to project item ID, and draft issue title to project item ID. Items whose content was deleted or is inaccessible are skipped.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ITEM_INDEX_USER_TITLE", "Get project item index"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			withMaxItems(),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
 
-		// UNDERSTANDING: Return comprehensive project details for immediate use
-		// INTEGRATION: Project ID can be used immediately with AddItemToProject tool
-		response := map[string]interface{}{
-			"success":     true,
-			"message":     "Project created successfully",
-			"project_id":  createProjectMutation.CreateProjectV2.ProjectV2.ID,
-			"project_number": int(createProjectMutation.CreateProjectV2.ProjectV2.Number),
-			"title":       createProjectMutation.CreateProjectV2.ProjectV2.Title,
-			"url":         createProjectMutation.CreateProjectV2.ProjectV2.URL,
-			"description": createProjectMutation.CreateProjectV2.ProjectV2.ShortDescription,
-			"created_at":  createProjectMutation.CreateProjectV2.ProjectV2.CreatedAt,
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			byURL := map[string]string{}
+			drafts := map[string]string{}
+			duplicateDrafts := []map[string]interface{}{}
+			skipped := 0
+			for _, item := range fetched.Items {
+				switch {
+				case item.Type == "DRAFT_ISSUE":
+					if _, ok := drafts[item.Title]; ok {
+						duplicateDrafts = append(duplicateDrafts, map[string]interface{}{"title": item.Title, "item_id": item.ID})
+						continue
+					}
+					drafts[item.Title] = item.ID
+				case item.URL != "":
+					byURL[item.URL] = item.ID
+				default:
+					skipped++
+				}
+			}
+
+			response := map[string]interface{}{
+				"project_id":    params.ProjectID,
+				"items":         byURL,
+				"draft_items":   drafts,
+				"skipped_count": skipped,
+			}
+			if len(duplicateDrafts) > 0 {
+				response["duplicate_draft_titles"] = duplicateDrafts
+			}
+			fetched.addTruncation(response)
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
 
-		responseJSON, err := json.Marshal(response)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+// UNDERSTANDING: List an organization's boards directly, without the owner type lookup list_user_projects may need
+// EXPECTS: org login, optional first (default 10, max 100) and after cursor
+// RETURNS: The same shape as list_user_projects, including total_count so callers can size their paging loop
+// INTEGRATION: Queries organization(login:){projectsV2} through the shared fetchOwnerProjects
+func ListOrgProjects(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_org_projects",
+			mcp.WithDescription(t("TOOL_LIST_ORG_PROJECTS_DESCRIPTION", "List GitHub Projects v2 boards owned by an organization. Use this to find project IDs of org-level boards.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_ORG_PROJECTS_USER_TITLE", "List organization projects"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("org",
+				mcp.Required(),
+				mcp.Description("Organization login"),
+			),
+			mcp.WithNumber("first",
+				mcp.Description("Number of projects to retrieve (default: 10, max: 100)"),
+			),
+			mcp.WithString("after",
+				mcp.Description("Cursor for the next page: pass the end_cursor of the previous response while has_next_page is true"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				Org   string `mapstructure:"org"`
+				First *int   `mapstructure:"first"`
+				After string `mapstructure:"after"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			first := 10
+			if params.First != nil {
+				first = max(1, min(*params.First, 100))
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var after *githubv4.String
+			if params.After != "" {
+				after = githubv4.NewString(githubv4.String(params.After))
+			}
+			projects, err := fetchOwnerProjects(ctx, client, params.Org, "organization", first, after)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to query organization projects", err), nil
+			}
+
+			response := map[string]interface{}{
+				"login":         params.Org,
+				"owner_type":    "organization",
+				"projects":      projects.Nodes,
+				"total_count":   int(projects.TotalCount),
+				"has_next_page": bool(projects.PageInfo.HasNextPage),
+				"end_cursor":    projects.PageInfo.EndCursor,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
+
+// linkedPullRequest is a pull request linked to an issue from its timeline.
+type linkedPullRequest struct {
+	Number githubv4.Int
+	Title  githubv4.String
+	URL    githubv4.String
+	State  githubv4.String
+}
+
+// projectItemDevLinksQuery selects an item's issue branches and pull request links, or a pull request's own branch.
+type projectItemDevLinksQuery struct {
+	Node struct {
+		ProjectV2Item struct {
+			ID      githubv4.ID
+			Type    githubv4.String
+			Project struct {
+				ID githubv4.ID
+			}
+			Content *struct {
+				Issue struct {
+					URL            githubv4.String
+					LinkedBranches struct {
+						Nodes []struct {
+							Ref *struct {
+								Name       githubv4.String
+								Repository struct {
+									NameWithOwner githubv4.String
+									URL           githubv4.String
+								}
+							}
+						}
+					} `graphql:"linkedBranches(first: 25)"`
+					TimelineItems struct {
+						Nodes []struct {
+							Typename       githubv4.String `graphql:"__typename"`
+							ConnectedEvent struct {
+								Subject struct {
+									PullRequest linkedPullRequest `graphql:"... on PullRequest"`
+								}
+							} `graphql:"... on ConnectedEvent"`
+							CrossReferencedEvent struct {
+								WillCloseTarget githubv4.Boolean
+								Source          struct {
+									PullRequest linkedPullRequest `graphql:"... on PullRequest"`
+								}
+							} `graphql:"... on CrossReferencedEvent"`
+						}
+					} `graphql:"timelineItems(first: 100, itemTypes: [CONNECTED_EVENT, CROSS_REFERENCED_EVENT])"`
+				} `graphql:"... on Issue"`
+				PullRequest struct {
+					URL            githubv4.String
+					HeadRefName    githubv4.String
+					HeadRepository *struct {
+						NameWithOwner githubv4.String
+						URL           githubv4.String
+					}
+				} `graphql:"... on PullRequest"`
+			}
+		} `graphql:"... on ProjectV2Item"`
+	} `graphql:"node(id: $id)"`
+}
 
-		return mcp.NewToolResultText(string(responseJSON)), nil
+// devLinkPullRequest describes a linked pull request in get_project_item_dev_links output.
+func devLinkPullRequest(pr linkedPullRequest, link string, willClose bool) map[string]interface{} {
+	return map[string]interface{}{
+		"number":           pr.Number,
+		"title":            pr.Title,
+		"url":              pr.URL,
+		"state":            pr.State,
+		"link":             link,
+		"will_close_issue": willClose,
 	}
 }
 
-// UNDERSTANDING: Core function to add an issue/PR to a GitHub Projects v2 board
-// EXPECTS: issue_url (full GitHub URL), project_id (from GitHub Projects v2 API)
-// RETURNS: Success confirmation with item details
-// INTEGRATION: Uses GraphQL mutation addProjectV2ItemById following existing MCP patterns
-func AddItemToProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
-	return mcp.NewTool("add_item_to_project",
-		mcp.WithDescription(t("TOOL_ADD_ITEM_TO_PROJECT_DESCRIPTION", "Add an issue or pull request to a GitHub Projects v2 board. This bridges the gap between issue creation and project management by automatically adding items to project boards.")),
-		mcp.WithToolAnnotation(mcp.ToolAnnotation{
-			Title:        t("TOOL_ADD_ITEM_TO_PROJECT_USER_TITLE", "Add item to project"),
-			ReadOnlyHint: ToBoolPtr(false),
-		}),
-		mcp.WithString("project_id",
-			mcp.Required(),
-			mcp.Description("GitHub Projects v2 project ID (use list_user_projects to find this)"),
-		),
-		mcp.WithString("issue_url",
-			mcp.Required(),
-			mcp.Description("Full GitHub URL of the issue or pull request (e.g., 'https://github.com/owner/repo/issues/123')"),
+// UNDERSTANDING: Jump from a board card to the code: the branches and pull requests linked to its issue
+// EXPECTS: project_id, item_id
+// RETURNS: The item's branches and pull requests with their URLs
+// INTEGRATION: Pull requests come from the issue timeline (manually connected or referencing the issue); for a pull
+// request item, its own head branch is returned
+func GetProjectItemDevLinks(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_item_dev_links",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ITEM_DEV_LINKS_DESCRIPTION", "Get the branches and pull requests linked to a GitHub Projects v2 item, with their URLs. For an issue, returns the branches created from it and the pull requests connected to or referencing it on its timeline; for a pull request, returns its head branch.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ITEM_DEV_LINKS_USER_TITLE", "Get project item branches and pull requests"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format)"),
+			),
 		),
-	),
-	func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var params struct {
-			ProjectID string `mapstructure:"project_id"`
-			IssueURL  string `mapstructure:"issue_url"`
-		}
-		if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
-		}
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				ItemID    string `mapstructure:"item_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
-		// UNDERSTANDING: Get GraphQL client following existing patterns
-		// VERIFIED: Same pattern used in pullrequests.go:1155-1158
-		client, err := getGQLClient(ctx)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
-		}
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var query projectItemDevLinksQuery
+			if err := client.Query(ctx, &query, map[string]interface{}{
+				"id": githubv4.ID(params.ItemID),
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+			}
+			item := query.Node.ProjectV2Item
+			if item.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s not found or is not a project item", params.ItemID)), nil
+			}
+			if fmt.Sprint(item.Project.ID) != params.ProjectID {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s does not belong to project %s", params.ItemID, params.ProjectID)), nil
+			}
+			if item.Content == nil || (item.Type != "ISSUE" && item.Type != "PULL_REQUEST") {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s is a %s; only issues and pull requests have branches and pull requests", params.ItemID, item.Type)), nil
+			}
 
-		// UNDERSTANDING: Execute addProjectV2ItemById mutation
-		// EXPECTS: GitHub Projects v2 API requires project node ID and content ID  
-		// RETURNS: Item details including database ID for future operations
-		// INTEGRATION: Direct GraphQL mutation following GitHub's Projects v2 schema
-		var addItemMutation struct {
-			AddProjectV2ItemById struct {
-				Item struct {
-					ID         githubv4.ID
-					DatabaseID githubv4.Int
+			branches := []map[string]interface{}{}
+			pullRequests := []map[string]interface{}{}
+			var contentURL githubv4.String
+			if item.Type == "PULL_REQUEST" {
+				pr := item.Content.PullRequest
+				contentURL = pr.URL
+				if pr.HeadRepository != nil {
+					branches = append(branches, map[string]interface{}{
+						"name":       pr.HeadRefName,
+						"repository": pr.HeadRepository.NameWithOwner,
+						"url":        fmt.Sprintf("%s/tree/%s", pr.HeadRepository.URL, pr.HeadRefName),
+					})
+				}
+			} else {
+				issue := item.Content.Issue
+				contentURL = issue.URL
+				for _, branch := range issue.LinkedBranches.Nodes {
+					if branch.Ref == nil {
+						continue
+					}
+					branches = append(branches, map[string]interface{}{
+						"name":       branch.Ref.Name,
+						"repository": branch.Ref.Repository.NameWithOwner,
+						"url":        fmt.Sprintf("%s/tree/%s", branch.Ref.Repository.URL, branch.Ref.Name),
+					})
 				}
-			} `graphql:"addProjectV2ItemById(input: $input)"`
-		}
 
-		if err := client.Mutate(
-			ctx,
-			&addItemMutation,
-			githubv4.AddProjectV2ItemByIdInput{
-				ProjectID: githubv4.ID(params.ProjectID),
-				ContentID: githubv4.ID(params.IssueURL), // GitHub accepts URLs as content IDs
-			},
-			nil,
-		); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to add item to project: %v", err)), nil
-		}
+				// UNDERSTANDING: A pull request can be both connected and cross-referenced; it is listed once
+				seen := map[githubv4.String]bool{}
+				for _, event := range issue.TimelineItems.Nodes {
+					var pr linkedPullRequest
+					var link string
+					var willClose bool
+					switch event.Typename {
+					case "ConnectedEvent":
+						pr, link, willClose = event.ConnectedEvent.Subject.PullRequest, "connected", true
+					case "CrossReferencedEvent":
+						pr, link = event.CrossReferencedEvent.Source.PullRequest, "referenced"
+						willClose = bool(event.CrossReferencedEvent.WillCloseTarget)
+					}
+					if pr.URL == "" || seen[pr.URL] {
+						continue
+					}
+					seen[pr.URL] = true
+					pullRequests = append(pullRequests, devLinkPullRequest(pr, link, willClose))
+				}
+			}
 
-		// UNDERSTANDING: Return success response with item details
-		// INTEGRATION: Consistent with other MCP tool success responses
-		response := map[string]interface{}{
-			"success":     true,
-			"message":     "Item successfully added to project",
-			"item_id":     addItemMutation.AddProjectV2ItemById.Item.ID,
-			"database_id": int(addItemMutation.AddProjectV2ItemById.Item.DatabaseID),
-		}
+			response := map[string]interface{}{
+				"project_id":    params.ProjectID,
+				"item_id":       params.ItemID,
+				"type":          item.Type,
+				"content_url":   contentURL,
+				"branches":      branches,
+				"pull_requests": pullRequests,
+			}
 
-		responseJSON, err := json.Marshal(response)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
-		}
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
 
-		return mcp.NewToolResultText(string(responseJSON)), nil
-	}
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
 }
 
-// UNDERSTANDING: List user's GitHub Projects v2 boards for project ID lookup
-// EXPECTS: login (GitHub username or organization name)
-// RETURNS: List of projects with IDs and metadata
-// INTEGRATION: Essential for finding project_id parameter for AddItemToProject
-func ListUserProjects(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
-	return mcp.NewTool("list_user_projects",
-		mcp.WithDescription(t("TOOL_LIST_USER_PROJECTS_DESCRIPTION", "List GitHub Projects v2 boards for a user or organization. Use this to find project IDs needed for adding items to projects.")),
-		mcp.WithToolAnnotation(mcp.ToolAnnotation{
-			Title:        t("TOOL_LIST_USER_PROJECTS_USER_TITLE", "List user projects"),
-			ReadOnlyHint: ToBoolPtr(true),
-		}),
-		mcp.WithString("login",
-			mcp.Required(),
-			mcp.Description("GitHub username or organization name"),
-		),
-		mcp.WithNumber("first",
-			mcp.Description("Number of projects to retrieve (default: 10, max: 100)"),
-		),
-	),
-	func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var params struct {
-			Login string  `mapstructure:"login"`
-			First *int    `mapstructure:"first"`
+// maxAddItemsPerDocument caps how many addProjectV2ItemById mutations are aliased into one GraphQL document.
+const maxAddItemsPerDocument = 50
+
+// addProjectItemsBatch adds several contents to a project with one GraphQL document, aliasing one
+// addProjectV2ItemById per content. The returned item IDs line up with contentIDs; on error, entries whose
+// mutation succeeded before the failure are still set and the rest are empty.
+// UNDERSTANDING: githubv4 always sends the first input as $input, so the others are declared as $input1, $input2, ...
+func addProjectItemsBatch(ctx context.Context, client *githubv4.Client, projectID string, contentIDs []string) ([]string, error) {
+	payloadType := reflect.TypeOf(struct {
+		Item *struct {
+			ID githubv4.ID
 		}
-		if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+	}{})
+	fields := make([]reflect.StructField, len(contentIDs))
+	variables := map[string]interface{}{}
+	var firstInput githubv4.AddProjectV2ItemByIdInput
+	for i, contentID := range contentIDs {
+		input := githubv4.AddProjectV2ItemByIdInput{
+			ProjectID: githubv4.ID(projectID),
+			ContentID: githubv4.ID(contentID),
 		}
-
-		// UNDERSTANDING: Default pagination following GitHub API best practices
-		// VERIFIED: Consistent with existing pagination in discussions.go:16
-		if params.First == nil {
-			defaultFirst := 10
-			params.First = &defaultFirst
+		variable := "input"
+		if i == 0 {
+			firstInput = input
+		} else {
+			variable = fmt.Sprintf("input%d", i)
+			variables[variable] = input
 		}
-		if *params.First > 100 {
-			*params.First = 100
+		fields[i] = reflect.StructField{
+			Name: fmt.Sprintf("Add%d", i),
+			Type: payloadType,
+			Tag:  reflect.StructTag(fmt.Sprintf(`graphql:"add%d: addProjectV2ItemById(input: $%s)"`, i, variable)),
 		}
+	}
 
-		client, err := getGQLClient(ctx)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
-		}
-
-		// UNDERSTANDING: Query Projects v2 using GitHub's GraphQL schema
-		// EXPECTS: User/Organization login and pagination parameters
-		// RETURNS: Project list with IDs, titles, URLs, and metadata
-		// INTEGRATION: Standard GraphQL query pattern following existing codebase structure
-		var projectsQuery struct {
-			User struct {
-				ProjectsV2 struct {
-					Nodes []struct {
-						ID          githubv4.ID
-						Number      githubv4.Int
-						Title       githubv4.String
-						URL         githubv4.String
-						Closed      githubv4.Boolean
-						CreatedAt   githubv4.DateTime
-						UpdatedAt   githubv4.DateTime
-						Description githubv4.String
-					}
-					TotalCount githubv4.Int
-					PageInfo   struct {
-						HasNextPage githubv4.Boolean
-						EndCursor   githubv4.String
-					}
-				} `graphql:"projectsV2(first: $first)"`
-			} `graphql:"user(login: $login)"`
+	mutation := reflect.New(reflect.StructOf(fields))
+	err := client.Mutate(ctx, mutation.Interface(), firstInput, variables)
+	itemIDs := make([]string, len(contentIDs))
+	for i := range contentIDs {
+		payload := mutation.Elem().Field(i)
+		if item := payload.FieldByName("Item"); !item.IsNil() {
+			itemIDs[i] = fmt.Sprint(item.Elem().FieldByName("ID").Interface())
 		}
+	}
+	return itemIDs, err
+}
 
-		if err := client.Query(ctx, &projectsQuery, map[string]interface{}{
-			"login": githubv4.String(params.Login),
-			"first": githubv4.Int(*params.First),
-		}); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to query user projects: %v", err)), nil
+// addProjectItemsChunk resolves and adds one chunk of add_items_to_project URLs, returning a result per URL in input
+// order and whether any failure was a rate limit. The adds are aliased into one GraphQL document per 50 items; if a
+// document fails, the items it did not add are retried one by one so each gets its own error.
+func addProjectItemsChunk(ctx context.Context, client *githubv4.Client, projectID string, issueURLs []string) ([]map[string]interface{}, bool) {
+	results := make([]map[string]interface{}, len(issueURLs))
+	var pending []int
+	for i, issueURL := range issueURLs {
+		results[i] = map[string]interface{}{"issue_url": issueURL, "success": false}
+		contentID := strings.TrimSpace(issueURL)
+		if strings.Contains(contentID, "/") {
+			content, err := resolveProjectContent(ctx, client, contentID)
+			if err != nil {
+				results[i]["error"] = fmt.Sprintf("failed to resolve issue_url: %v", err)
+				continue
+			}
+			contentID = content.ContentID
 		}
+		results[i]["content_id"] = contentID
+		pending = append(pending, i)
+	}
 
-		responseJSON, err := json.Marshal(projectsQuery)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+	var addItemMutation struct {
+		AddProjectV2ItemById struct {
+			Item struct {
+				ID githubv4.ID
+			}
+		} `graphql:"addProjectV2ItemById(input: $input)"`
+	}
+	for batch := range slices.Chunk(pending, maxAddItemsPerDocument) {
+		contentIDs := make([]string, len(batch))
+		for j, i := range batch {
+			contentIDs[j] = results[i]["content_id"].(string)
 		}
+		itemIDs, batchErr := addProjectItemsBatch(ctx, client, projectID, contentIDs)
+		for j, i := range batch {
+			if itemIDs[j] != "" {
+				results[i]["success"] = true
+				results[i]["item_id"] = itemIDs[j]
+				continue
+			}
+			if batchErr == nil {
+				results[i]["error"] = "GitHub returned no project item"
+				continue
+			}
+			if err := client.Mutate(ctx, &addItemMutation, githubv4.AddProjectV2ItemByIdInput{
+				ProjectID: githubv4.ID(projectID),
+				ContentID: githubv4.ID(contentIDs[j]),
+			}, nil); err != nil {
+				results[i]["error"] = fmt.Sprintf("failed to add item to project: %v", err)
+				continue
+			}
+			results[i]["success"] = true
+			results[i]["item_id"] = addItemMutation.AddProjectV2ItemById.Item.ID
+		}
+	}
 
-		return mcp.NewToolResultText(string(responseJSON)), nil
+	rateLimited := false
+	for _, result := range results {
+		if message, ok := result["error"].(string); ok && mentionsRateLimit(message) {
+			rateLimited = true
+		}
 	}
+	return results, rateLimited
 }
 
-// UNDERSTANDING: Update project item field values (move between columns, update status)
-// EXPECTS: project_id, item_id, field_id, value (string/single_select/date/number)
-// RETURNS: Success confirmation with updated field details
-// INTEGRATION: Enables workflow automation by updating project board item states
-func UpdateProjectItemStatus(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
-	return mcp.NewTool("update_project_item_status",
-		mcp.WithDescription(t("TOOL_UPDATE_PROJECT_ITEM_STATUS_DESCRIPTION", "Update a project item's field value (status, assignee, priority, etc.) in GitHub Projects v2. Use this to move items between columns or update custom fields.")),
+// UNDERSTANDING: Add many issues or pull requests to a board without one agent round trip per item
+// EXPECTS: project_id, issue_urls (URLs or content node IDs, at most 100), optional auto_chunk and max_batch_size
+// to split batches over 100 URLs
+// RETURNS: One result per URL, in input order, with its item_id or the error that stopped it
+// INTEGRATION: Chunks run through runProjectChunks like add_items_to_project_with_status; within a chunk the adds are
+// aliased by addProjectItemsChunk. addProjectV2ItemById is idempotent, so retries never duplicate
+func AddItemsToProject(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	options := []mcp.ToolOption{
+		mcp.WithDescription(t("TOOL_ADD_ITEMS_TO_PROJECT_DESCRIPTION", "Add several issues or pull requests to a GitHub Projects v2 board in one call. The adds are batched into as few GraphQL requests as possible; one failing item does not stop the others. Returns a result per item with its project item ID or the specific error. Items already on the board are returned rather than duplicated. Set auto_chunk to add more than 100 items in chunks.")),
 		mcp.WithToolAnnotation(mcp.ToolAnnotation{
-			Title:        t("TOOL_UPDATE_PROJECT_ITEM_STATUS_USER_TITLE", "Update project item status"),
+			Title:        t("TOOL_ADD_ITEMS_TO_PROJECT_USER_TITLE", "Add items to project"),
 			ReadOnlyHint: ToBoolPtr(false),
 		}),
 		mcp.WithString("project_id",
 			mcp.Required(),
 			mcp.Description("GitHub Projects v2 project ID"),
 		),
-		mcp.WithString("item_id", 
-			mcp.Required(),
-			mcp.Description("Project item ID (returned from add_item_to_project)"),
-		),
-		mcp.WithString("field_id",
-			mcp.Required(), 
-			mcp.Description("Project field ID to update (use get_project_fields to find this)"),
-		),
-		mcp.WithString("value",
+		mcp.WithArray("issue_urls",
 			mcp.Required(),
-			mcp.Description("New field value (string for text fields, option ID for single select fields)"),
+			mcp.Items(map[string]interface{}{"type": "string"}),
+			mcp.Description(fmt.Sprintf("Full GitHub URLs of the issues or pull requests to add, or their node IDs (I_xxxx or PR_xxxx); at most %d, or %d with auto_chunk", maxProjectBatchSize, maxAutoChunkItems)),
 		),
-	),
-	func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var params struct {
-			ProjectID string `mapstructure:"project_id"`
-			ItemID    string `mapstructure:"item_id"`
-			FieldID   string `mapstructure:"field_id"`
-			Value     string `mapstructure:"value"`
-		}
-		if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+	}
+	options = append(options, withAutoChunkParams()...)
+
+	return mcp.NewTool("add_items_to_project", options...),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID    string   `mapstructure:"project_id"`
+				IssueURLs    []string `mapstructure:"issue_urls"`
+				AutoChunk    bool     `mapstructure:"auto_chunk"`
+				MaxBatchSize int      `mapstructure:"max_batch_size"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.IssueURLs) == 0 {
+				return mcp.NewToolResultError("issue_urls must contain at least one URL"), nil
+			}
+			chunkSize, err := projectChunkSize(len(params.IssueURLs), params.AutoChunk, params.MaxBatchSize, "issue_urls", "URLs")
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			results := make([]map[string]interface{}, 0, len(params.IssueURLs))
+			run := runProjectChunks(ctx, len(params.IssueURLs), chunkSize, func(start, end int) bool {
+				chunkResults, rateLimited := addProjectItemsChunk(ctx, client, params.ProjectID, params.IssueURLs[start:end])
+				results = append(results, chunkResults...)
+				return rateLimited
+			})
+
+			added := 0
+			for _, result := range results {
+				if result["success"] == true {
+					added++
+				}
+			}
+			response := map[string]interface{}{
+				"success":      added == len(params.IssueURLs),
+				"message":      fmt.Sprintf("%d of %d items added", added, len(params.IssueURLs)),
+				"project_id":   params.ProjectID,
+				"results":      results,
+				"added_count":  added,
+				"failed_count": len(results) - added,
+			}
+			if params.AutoChunk {
+				response["chunk_count"] = run.Chunks
+			}
+			if run.StoppedEarly {
+				response["stopped_early"] = true
+				response["remaining_count"] = len(params.IssueURLs) - len(results)
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
 
-		client, err := getGQLClient(ctx)
+// blockedByReferences normalizes blocking issue URLs to "owner/repo#number" references, dropping duplicates.
+func blockedByReferences(urls []string) ([]string, error) {
+	references := make([]string, 0, len(urls))
+	seen := map[string]bool{}
+	for _, rawURL := range urls {
+		owner, repo, kind, number, err := parseProjectContentURL(rawURL)
 		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			return nil, err
 		}
-
-		// UNDERSTANDING: Update project item field using GitHub's updateProjectV2ItemFieldValue mutation
-		// EXPECTS: Project ID, item ID, field ID, and properly formatted value
-		// RETURNS: Updated field details including the new value
-		// INTEGRATION: Core workflow automation - moves items between columns and updates statuses
-		var updateFieldMutation struct {
-			UpdateProjectV2ItemFieldValue struct {
-				ProjectV2Item struct {
-					ID githubv4.ID
-				}
-			} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+		if kind == "discussions" {
+			return nil, fmt.Errorf("%s is a discussion URL; blockers must be issues or pull requests", rawURL)
 		}
+		reference := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+		if seen[strings.ToLower(reference)] {
+			continue
+		}
+		seen[strings.ToLower(reference)] = true
+		references = append(references, reference)
+	}
+	return references, nil
+}
+
+// UNDERSTANDING: Track dependencies with the common "Blocked by" text field convention
+// EXPECTS: project_id, item_id, blocking_urls (issue or pull request URLs), optional field_name (default "Blocked by")
+// RETURNS: The references written to the field
+// INTEGRATION: References are written as "owner/repo#number" joined by ", ", so the value reads the same whatever URL
+// form was given and GitHub renders each reference as a link
+func SetProjectItemBlockedBy(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_project_item_blocked_by",
+			mcp.WithDescription(t("TOOL_SET_PROJECT_ITEM_BLOCKED_BY_DESCRIPTION", "Record which issues block a GitHub Projects v2 item by writing normalized references (owner/repo#number, comma-separated) into a text field such as \"Blocked by\". Replaces the field's current value.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_PROJECT_ITEM_BLOCKED_BY_USER_TITLE", "Set project item blockers"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format) of the blocked item"),
+			),
+			mcp.WithArray("blocking_urls",
+				mcp.Required(),
+				mcp.Items(map[string]interface{}{"type": "string"}),
+				mcp.Description("Full GitHub URLs of the issues or pull requests blocking the item"),
+			),
+			mcp.WithString("field_name",
+				mcp.Description("Name of the text field holding blockers (default: 'Blocked by')"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID    string   `mapstructure:"project_id"`
+				ItemID       string   `mapstructure:"item_id"`
+				BlockingURLs []string `mapstructure:"blocking_urls"`
+				FieldName    string   `mapstructure:"field_name"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if len(params.BlockingURLs) == 0 {
+				return mcp.NewToolResultError("blocking_urls must contain at least one URL; use clear_project_item_field to remove all blockers"), nil
+			}
+			if params.FieldName == "" {
+				params.FieldName = "Blocked by"
+			}
+			references, err := blockedByReferences(params.BlockingURLs)
+			if err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
 
-		if err := client.Mutate(
-			ctx,
-			&updateFieldMutation,
-			githubv4.UpdateProjectV2ItemFieldValueInput{
+			fields, err := fetchProjectFields(ctx, client, params.ProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+			}
+			field, ok := findProjectField(fields, params.FieldName)
+			if !ok {
+				return mcp.NewToolResultError(fmt.Sprintf("field %q not found in project", params.FieldName)), nil
+			}
+			if field.DataType != "TEXT" {
+				return mcp.NewToolResultError(fmt.Sprintf("field %q is a %s field, not a text field", field.Name, field.DataType)), nil
+			}
+
+			value := strings.Join(references, ", ")
+			var updateFieldMutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
 				ProjectID: githubv4.ID(params.ProjectID),
 				ItemID:    githubv4.ID(params.ItemID),
-				FieldID:   githubv4.ID(params.FieldID),
+				FieldID:   githubv4.ID(field.ID),
 				Value: githubv4.ProjectV2FieldValue{
-					Text: githubv4.NewString(githubv4.String(params.Value)),
+					Text: githubv4.NewString(githubv4.String(value)),
 				},
-			},
-			nil,
-		); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to update project item field: %v", err)), nil
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to update project item field", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":    true,
+				"message":    fmt.Sprintf("%s set to %s", field.Name, value),
+				"item_id":    params.ItemID,
+				"field_id":   field.ID,
+				"field_name": field.Name,
+				"blocked_by": references,
+				"value":      value,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
+
+// projectItemSubIssuesQuery fetches a project item's issue with its sub-issue counts.
+type projectItemSubIssuesQuery struct {
+	Node struct {
+		ProjectV2Item struct {
+			ID      githubv4.ID
+			Type    githubv4.String
+			Project struct {
+				ID githubv4.ID
+			}
+			Content *struct {
+				Issue struct {
+					Title            githubv4.String
+					URL              githubv4.String
+					SubIssuesSummary struct {
+						Total     githubv4.Int
+						Completed githubv4.Int
+					}
+				} `graphql:"... on Issue"`
+			}
+		} `graphql:"... on ProjectV2Item"`
+	} `graphql:"node(id: $id)"`
+}
+
+// UNDERSTANDING: Estimate a parent issue by the number of its sub-issues
+// EXPECTS: project_id, item_id (an issue item), field_id of a NUMBER field such as Estimate or Points
+// RETURNS: The sub-issue count written to the field, plus how many sub-issues are completed
+// INTEGRATION: Counts come from the issue's sub-issue summary, so every sub-issue counts whether or not it is on the board
+func EstimateProjectItemFromSubIssues(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("estimate_project_item_from_sub_issues",
+			mcp.WithDescription(t("TOOL_ESTIMATE_PROJECT_ITEM_FROM_SUB_ISSUES_DESCRIPTION", "Set a number field (e.g. Estimate or Points) of a GitHub Projects v2 issue item to the number of sub-issues the issue has.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_ESTIMATE_PROJECT_ITEM_FROM_SUB_ISSUES_USER_TITLE", "Estimate project item from sub-issues"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format) of the parent issue"),
+			),
+			mcp.WithString("field_id",
+				mcp.Required(),
+				mcp.Description("ID of the NUMBER field to write the sub-issue count to"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				ItemID    string `mapstructure:"item_id"`
+				FieldID   string `mapstructure:"field_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
-		response := map[string]interface{}{
-			"success": true,
-			"message": "Project item field updated successfully",
-			"item_id": updateFieldMutation.UpdateProjectV2ItemFieldValue.ProjectV2Item.ID,
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			field, err := fetchProjectField(ctx, client, params.FieldID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project field", err), nil
+			}
+			if field.DataType != "NUMBER" {
+				return mcp.NewToolResultError(fmt.Sprintf("field %q is a %s field, not a number field", field.Name, field.DataType)), nil
+			}
+
+			var query projectItemSubIssuesQuery
+			if err := client.Query(ctx, &query, map[string]interface{}{
+				"id": githubv4.ID(params.ItemID),
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+			}
+			item := query.Node.ProjectV2Item
+			if item.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s not found or is not a project item", params.ItemID)), nil
+			}
+			if fmt.Sprint(item.Project.ID) != params.ProjectID {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s does not belong to project %s", params.ItemID, params.ProjectID)), nil
+			}
+			if item.Content == nil || item.Type != "ISSUE" {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s is a %s; only issues have sub-issues", params.ItemID, item.Type)), nil
+			}
+			issue := item.Content.Issue
+			total := int(issue.SubIssuesSummary.Total)
+
+			var updateFieldMutation struct {
+				UpdateProjectV2ItemFieldValue struct {
+					ProjectV2Item struct {
+						ID githubv4.ID
+					}
+				} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &updateFieldMutation, githubv4.UpdateProjectV2ItemFieldValueInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				ItemID:    githubv4.ID(params.ItemID),
+				FieldID:   githubv4.ID(field.ID),
+				Value: githubv4.ProjectV2FieldValue{
+					Number: githubv4.NewFloat(githubv4.Float(total)),
+				},
+			}, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to update project item field", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":              true,
+				"message":              fmt.Sprintf("%s set to %d, the number of sub-issues", field.Name, total),
+				"item_id":              params.ItemID,
+				"title":                issue.Title,
+				"url":                  issue.URL,
+				"field_id":             field.ID,
+				"field_name":           field.Name,
+				"sub_issue_count":      total,
+				"completed_sub_issues": int(issue.SubIssuesSummary.Completed),
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
 
-		responseJSON, err := json.Marshal(response)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+// projectItemStatusQuery fetches one item's field values without its content.
+type projectItemStatusQuery struct {
+	Node struct {
+		ProjectV2Item struct {
+			ID         githubv4.ID
+			Type       githubv4.String
+			IsArchived githubv4.Boolean
+			Project    struct {
+				ID githubv4.ID
+			}
+			FieldValues struct {
+				Nodes []projectItemFieldValueNode
+			} `graphql:"fieldValues(first: 50)"`
+		} `graphql:"... on ProjectV2Item"`
+	} `graphql:"node(id: $id)"`
+}
+
+// UNDERSTANDING: Check which column one card is in without walking the board
+// EXPECTS: project_id, item_id, optional status_field_name (default "Status")
+// RETURNS: status (option name or null), iteration title, and every field value of the item
+// INTEGRATION: A single node query, so status checks stay cheap however large the board is
+func GetProjectItemStatus(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("get_project_item_status",
+			mcp.WithDescription(t("TOOL_GET_PROJECT_ITEM_STATUS_DESCRIPTION", "Get the field values of a single GitHub Projects v2 item, including its Status and iteration, without listing the whole board.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_GET_PROJECT_ITEM_STATUS_USER_TITLE", "Get project item status"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format)"),
+			),
+			mcp.WithString("status_field_name",
+				mcp.Description("Name of the single-select field reported as status (default: 'Status')"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID       string `mapstructure:"project_id"`
+				ItemID          string `mapstructure:"item_id"`
+				StatusFieldName string `mapstructure:"status_field_name"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.StatusFieldName == "" {
+				params.StatusFieldName = "Status"
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var query projectItemStatusQuery
+			if err := client.Query(ctx, &query, map[string]interface{}{
+				"id": githubv4.ID(params.ItemID),
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project item", err), nil
+			}
+			item := query.Node.ProjectV2Item
+			if item.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s not found or is not a project item", params.ItemID)), nil
+			}
+			if fmt.Sprint(item.Project.ID) != params.ProjectID {
+				return mcp.NewToolResultError(fmt.Sprintf("item %s does not belong to project %s", params.ItemID, params.ProjectID)), nil
+			}
+
+			fieldValues := newProjectFieldValues(item.FieldValues.Nodes)
+			var status, statusOptionID, iteration, iterationID interface{}
+			for _, value := range fieldValues {
+				switch {
+				case value.Type == "SINGLE_SELECT" && status == nil && strings.EqualFold(value.FieldName, params.StatusFieldName):
+					status, statusOptionID = value.Value, value.OptionID
+				case value.Type == "ITERATION" && iteration == nil:
+					iteration, iterationID = value.Value, value.IterationID
+				}
+			}
+			if fieldValues == nil {
+				fieldValues = []projectFieldValue{}
+			}
+
+			response := map[string]interface{}{
+				"item_id":          params.ItemID,
+				"type":             item.Type,
+				"archived":         item.IsArchived,
+				"status":           status,
+				"status_option_id": statusOptionID,
+				"iteration":        iteration,
+				"iteration_id":     iterationID,
+				"field_values":     fieldValues,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
 
-		return mcp.NewToolResultText(string(responseJSON)), nil
+// invalidRepositoryReason explains why a project item's repository can no longer be reached, or returns "".
+func invalidRepositoryReason(item projectItem) string {
+	switch {
+	case item.Type == "REDACTED":
+		return "inaccessible"
+	case item.Orphaned:
+		return "deleted"
+	case (item.Type == "ISSUE" || item.Type == "PULL_REQUEST") && item.Repository == "":
+		return "missing_repository"
 	}
+	return ""
 }
 
-// UNDERSTANDING: Link an existing GitHub Projects v2 board to a repository
-// EXPECTS: project_id (Projects v2 ID), repository_id (repository node ID)
-// RETURNS: Success confirmation of the linking operation
-// INTEGRATION: Solves the "put it back" use case - reconnect existing projects to repositories
-func LinkProjectToRepository(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
-	return mcp.NewTool("link_project_to_repository",
-		mcp.WithDescription(t("TOOL_LINK_PROJECT_TO_REPOSITORY_DESCRIPTION", "Link an existing GitHub Projects v2 board to a repository. Use this to reconnect projects that were previously unlinked or to establish new project-repository associations.")),
-		mcp.WithToolAnnotation(mcp.ToolAnnotation{
-			Title:        t("TOOL_LINK_PROJECT_TO_REPOSITORY_USER_TITLE", "Link project to repository"),
-			ReadOnlyHint: ToBoolPtr(false),
-		}),
-		mcp.WithString("project_id",
-			mcp.Required(),
-			mcp.Description("GitHub Projects v2 project ID (PVT_xxxx format)"),
-		),
-		mcp.WithString("repository_id",
-			mcp.Required(),
-			mcp.Description("GitHub repository node ID (R_xxxx format) - use get_repository to find this"),
+// UNDERSTANDING: Clean up aggregate boards whose items point at repositories that were deleted or locked away
+// EXPECTS: project_id, optional max_items
+// RETURNS: Items whose content repository is gone or not visible, each with a reason, plus a count per reason
+// INTEGRATION: Reasons are "deleted" (content or its repository was deleted), "inaccessible" (GitHub redacts the item
+// for the current token) and "missing_repository" (the content came back without a repository)
+func FindProjectItemsWithInvalidRepo(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("find_project_items_with_invalid_repo",
+			mcp.WithDescription(t("TOOL_FIND_PROJECT_ITEMS_WITH_INVALID_REPO_DESCRIPTION", "Find items on a GitHub Projects v2 board whose issue or pull request repository was deleted or is not accessible with the current token. Draft issues are never reported.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_FIND_PROJECT_ITEMS_WITH_INVALID_REPO_USER_TITLE", "Find project items with invalid repository"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			withMaxItems(),
 		),
-	),
-	func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var params struct {
-			ProjectID    string `mapstructure:"project_id"`
-			RepositoryID string `mapstructure:"repository_id"`
-		}
-		if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
-		}
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				MaxItems  int    `mapstructure:"max_items"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
 
-		// UNDERSTANDING: Get GraphQL client following existing patterns
-		// VERIFIED: Same pattern used in other Projects v2 tools
-		client, err := getGQLClient(ctx)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
-		}
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
 
-		// UNDERSTANDING: Execute linkProjectV2ToRepository mutation
-		// EXPECTS: GitHub Projects v2 project ID and repository node ID  
-		// RETURNS: Success confirmation of the linking operation
-		// INTEGRATION: Direct GraphQL mutation following GitHub's Projects v2 schema
-		// VERIFIED: Payload only returns repository field, not project field
-		var linkProjectMutation struct {
-			LinkProjectV2ToRepository struct {
-				Repository struct {
-					ID   githubv4.ID
-					Name githubv4.String
+			fetched, err := fetchAllProjectItems(ctx, client, params.ProjectID, params.MaxItems)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project items", err), nil
+			}
+
+			invalid := []map[string]interface{}{}
+			reasonCounts := map[string]int{}
+			for _, item := range fetched.Items {
+				reason := invalidRepositoryReason(item)
+				if reason == "" {
+					continue
 				}
-			} `graphql:"linkProjectV2ToRepository(input: $input)"`
-		}
+				reasonCounts[reason]++
+				invalid = append(invalid, map[string]interface{}{
+					"id":       item.ID,
+					"type":     item.Type,
+					"position": item.Position,
+					"title":    item.Title,
+					"url":      item.URL,
+					"reason":   reason,
+				})
+			}
 
-		if err := client.Mutate(
-			ctx,
-			&linkProjectMutation,
-			githubv4.LinkProjectV2ToRepositoryInput{
-				ProjectID:    githubv4.ID(params.ProjectID),
-				RepositoryID: githubv4.ID(params.RepositoryID),
-			},
-			nil,
-		); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to link project to repository: %v", err)), nil
-		}
+			response := map[string]interface{}{
+				"project_id":    params.ProjectID,
+				"total_items":   len(fetched.Items),
+				"invalid_count": len(invalid),
+				"by_reason":     reasonCounts,
+				"items":         invalid,
+			}
+			fetched.addTruncation(response)
 
-		// UNDERSTANDING: Return success response with linking details
-		// INTEGRATION: Consistent with other MCP tool success responses
-		// VERIFIED: Only repository details available in mutation payload
-		response := map[string]interface{}{
-			"success":         true,
-			"message":         "Project successfully linked to repository",
-			"project_id":      params.ProjectID, // Use input parameter since not returned
-			"repository_id":   linkProjectMutation.LinkProjectV2ToRepository.Repository.ID,
-			"repository_name": linkProjectMutation.LinkProjectV2ToRepository.Repository.Name,
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
 
-		responseJSON, err := json.Marshal(response)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+// projectViewSettingsNode is a saved view with the field-based settings that make up its layout.
+type projectViewSettingsNode struct {
+	ID     githubv4.ID
+	Name   githubv4.String
+	Layout githubv4.ProjectV2ViewLayout
+	Filter githubv4.String
+	Fields struct {
+		Nodes []projectFieldRef
+	} `graphql:"fields(first: 50)"`
+	GroupByFields struct {
+		Nodes []projectFieldRef
+	} `graphql:"groupByFields(first: 10)"`
+	VerticalGroupByFields struct {
+		Nodes []projectFieldRef
+	} `graphql:"verticalGroupByFields(first: 10)"`
+	SortByFields struct {
+		Nodes []struct {
+			Direction githubv4.String
+			Field     projectFieldRef
 		}
+	} `graphql:"sortByFields(first: 10)"`
+}
+
+// projectViewSettingsQuery fetches a page of a project's saved views with their settings.
+type projectViewSettingsQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Views struct {
+				Nodes    []projectViewSettingsNode
+				PageInfo struct {
+					HasNextPage githubv4.Boolean
+					EndCursor   githubv4.String
+				}
+			} `graphql:"views(first: 100, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// projectViewSort is a field a view is sorted by.
+type projectViewSort struct {
+	Field     string `json:"field"`
+	Direction string `json:"direction"`
+}
+
+// projectViewSettings is the normalized view of a saved view's settings, with fields referenced by name.
+type projectViewSettings struct {
+	ID              string            `json:"-"`
+	Name            string            `json:"name"`
+	Layout          string            `json:"layout"`
+	Filter          string            `json:"filter"`
+	VisibleFields   []string          `json:"visible_fields"`
+	GroupBy         []string          `json:"group_by,omitempty"`
+	VerticalGroupBy []string          `json:"vertical_group_by,omitempty"`
+	SortBy          []projectViewSort `json:"sort_by,omitempty"`
+}
 
-		return mcp.NewToolResultText(string(responseJSON)), nil
+// fieldNames returns every field the view refers to, without duplicates.
+func (v projectViewSettings) fieldNames() []string {
+	names := slices.Concat(v.VisibleFields, v.GroupBy, v.VerticalGroupBy)
+	for _, sortBy := range v.SortBy {
+		names = append(names, sortBy.Field)
 	}
+	slices.Sort(names)
+	return slices.Compact(names)
 }
 
-// UNDERSTANDING: Unlink a GitHub Projects v2 board from a repository  
-// EXPECTS: project_id (Projects v2 ID), repository_id (repository node ID)
-// RETURNS: Success confirmation of the unlinking operation
-// INTEGRATION: Inverse operation to LinkProjectToRepository for complete project-repository management
-func UnlinkProjectFromRepository(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
-	return mcp.NewTool("unlink_project_from_repository",
-		mcp.WithDescription(t("TOOL_UNLINK_PROJECT_FROM_REPOSITORY_DESCRIPTION", "Unlink a GitHub Projects v2 board from a repository. The project and its data remain intact, but it will no longer appear in the repository's Projects tab.")),
-		mcp.WithToolAnnotation(mcp.ToolAnnotation{
-			Title:        t("TOOL_UNLINK_PROJECT_FROM_REPOSITORY_USER_TITLE", "Unlink project from repository"),
-			ReadOnlyHint: ToBoolPtr(false),
-		}),
-		mcp.WithString("project_id",
-			mcp.Required(),
-			mcp.Description("GitHub Projects v2 project ID (PVT_xxxx format)"),
-		),
-		mcp.WithString("repository_id",
-			mcp.Required(),
-			mcp.Description("GitHub repository node ID (R_xxxx format)"),
-		),
-	),
-	func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-		var params struct {
-			ProjectID    string `mapstructure:"project_id"`
-			RepositoryID string `mapstructure:"repository_id"`
+// fetchProjectViewSettings pages through the saved views of a project with their settings.
+func fetchProjectViewSettings(ctx context.Context, client *githubv4.Client, projectID string) ([]projectViewSettings, error) {
+	var nodes []projectViewSettingsNode
+	var after *githubv4.String
+	for {
+		var query projectViewSettingsQuery
+		if err := client.Query(ctx, &query, map[string]interface{}{
+			"id":    githubv4.ID(projectID),
+			"after": after,
+		}); err != nil {
+			return nil, err
 		}
-		if err := mapstructure.Decode(request.Params.Arguments, &params); err != nil {
-			return mcp.NewToolResultError(err.Error()), nil
+		nodes = append(nodes, query.Node.ProjectV2.Views.Nodes...)
+		pageInfo := query.Node.ProjectV2.Views.PageInfo
+		if !pageInfo.HasNextPage {
+			break
 		}
+		cursor := pageInfo.EndCursor
+		after = &cursor
+	}
 
-		// UNDERSTANDING: Get GraphQL client following existing patterns
-		// VERIFIED: Same pattern used in other Projects v2 tools
-		client, err := getGQLClient(ctx)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+	refNames := func(refs []projectFieldRef) []string {
+		names := []string{}
+		for _, ref := range refs {
+			if ref.Common.Name != "" {
+				names = append(names, string(ref.Common.Name))
+			}
+		}
+		return names
+	}
+	views := make([]projectViewSettings, 0, len(nodes))
+	for _, node := range nodes {
+		view := projectViewSettings{
+			ID:              fmt.Sprint(node.ID),
+			Name:            string(node.Name),
+			Layout:          string(node.Layout),
+			Filter:          string(node.Filter),
+			VisibleFields:   refNames(node.Fields.Nodes),
+			GroupBy:         refNames(node.GroupByFields.Nodes),
+			VerticalGroupBy: refNames(node.VerticalGroupByFields.Nodes),
+		}
+		for _, sortBy := range node.SortByFields.Nodes {
+			if sortBy.Field.Common.Name != "" {
+				view.SortBy = append(view.SortBy, projectViewSort{Field: string(sortBy.Field.Common.Name), Direction: string(sortBy.Direction)})
+			}
 		}
+		views = append(views, view)
+	}
+	return views, nil
+}
 
-		// UNDERSTANDING: Execute unlinkProjectV2FromRepository mutation
-		// EXPECTS: GitHub Projects v2 project ID and repository node ID
-		// RETURNS: Success confirmation of the unlinking operation  
-		// INTEGRATION: Direct GraphQL mutation following GitHub's Projects v2 schema
-		// VERIFIED: Payload only returns repository field, not project field
-		var unlinkProjectMutation struct {
-			UnlinkProjectV2FromRepository struct {
-				Repository struct {
-					ID   githubv4.ID
-					Name githubv4.String
+// UNDERSTANDING: Plan carrying a board's standard views over to another board by hand
+// EXPECTS: source_project_id, target_project_id
+// RETURNS: Per source view, the matching target view ID when one with the same name exists, otherwise the settings
+// to recreate it by hand and any fields the target lacks
+// INTEGRATION: VERIFIED: the GraphQL API has no mutation that creates or edits project views, so this only reports;
+// only copyProjectV2 (create_project_from_template) copies views, and only onto a new project
+func PlanProjectViewCopy(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("plan_project_view_copy",
+			mcp.WithDescription(t("TOOL_PLAN_PROJECT_VIEW_COPY_DESCRIPTION", "Plan copying the saved views of a source GitHub Projects v2 board onto a target board. This tool does not create any views: GitHub's API cannot create views, so they must be recreated by hand in the web UI. For each source view missing on the target it returns the layout, filter, visible fields, grouping and sorting to set up, and flags fields the target lacks. To copy views onto a new board, use create_project_from_template instead.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_PLAN_PROJECT_VIEW_COPY_USER_TITLE", "Plan project view copy"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("source_project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID of the board whose views are copied"),
+			),
+			mcp.WithString("target_project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID of the board receiving the views"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				SourceProjectID string `mapstructure:"source_project_id"`
+				TargetProjectID string `mapstructure:"target_project_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.SourceProjectID == params.TargetProjectID {
+				return mcp.NewToolResultError("source_project_id and target_project_id must be different projects"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			sourceViews, err := fetchProjectViewSettings(ctx, client, params.SourceProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project views", err), nil
+			}
+			targetViews, err := fetchProjectViewSettings(ctx, client, params.TargetProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project views", err), nil
+			}
+			targetFields, err := fetchProjectFields(ctx, client, params.TargetProjectID)
+			if err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project fields", err), nil
+			}
+
+			existing := []map[string]interface{}{}
+			toRecreate := []map[string]interface{}{}
+			for _, view := range sourceViews {
+				if i := slices.IndexFunc(targetViews, func(target projectViewSettings) bool {
+					return strings.EqualFold(target.Name, view.Name)
+				}); i >= 0 {
+					existing = append(existing, map[string]interface{}{
+						"name":           view.Name,
+						"target_view_id": targetViews[i].ID,
+					})
+					continue
 				}
-			} `graphql:"unlinkProjectV2FromRepository(input: $input)"`
-		}
 
-		if err := client.Mutate(
-			ctx,
-			&unlinkProjectMutation,
-			githubv4.UnlinkProjectV2FromRepositoryInput{
-				ProjectID:    githubv4.ID(params.ProjectID),
-				RepositoryID: githubv4.ID(params.RepositoryID),
-			},
-			nil,
-		); err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to unlink project from repository: %v", err)), nil
+				missingFields := []string{}
+				for _, name := range view.fieldNames() {
+					if _, ok := findProjectField(targetFields, name); !ok {
+						missingFields = append(missingFields, name)
+					}
+				}
+				toRecreate = append(toRecreate, map[string]interface{}{
+					"view":           view,
+					"missing_fields": missingFields,
+				})
+			}
+
+			response := map[string]interface{}{
+				"source_project_id": params.SourceProjectID,
+				"target_project_id": params.TargetProjectID,
+				"existing_views":    existing,
+				"views_to_recreate": toRecreate,
+				"unsupported_settings": "GitHub's API cannot create or edit project views, so these views must be recreated by hand; " +
+					"recreate each entry of views_to_recreate in the web UI, adding any missing_fields to the target first",
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
+
+// UNDERSTANDING: Reorder cards during prioritization
+// EXPECTS: project_id, item_id, optional after_item_id (the item is moved to the top when it is omitted)
+// RETURNS: Success confirmation with where the item now sits
+// INTEGRATION: Changes the manual board order that list_project_items follows and views sorted manually show
+func SetProjectItemPosition(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("set_project_item_position",
+			mcp.WithDescription(t("TOOL_SET_PROJECT_ITEM_POSITION_DESCRIPTION", "Move an item on a GitHub Projects v2 board so it comes right after another item, or to the top when no after_item_id is given. This changes the board's manual order.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_SET_PROJECT_ITEM_POSITION_USER_TITLE", "Set project item position"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("item_id",
+				mcp.Required(),
+				mcp.Description("Project item ID (PVTI_xxxx format) of the item to move"),
+			),
+			mcp.WithString("after_item_id",
+				mcp.Description("Project item ID to place the item after; omit to move it to the top"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID   string `mapstructure:"project_id"`
+				ItemID      string `mapstructure:"item_id"`
+				AfterItemID string `mapstructure:"after_item_id"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if params.AfterItemID != "" && params.AfterItemID == params.ItemID {
+				return mcp.NewToolResultError("after_item_id must be a different item than item_id"), nil
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			input := githubv4.UpdateProjectV2ItemPositionInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				ItemID:    githubv4.ID(params.ItemID),
+			}
+			if params.AfterItemID != "" {
+				afterID := githubv4.ID(params.AfterItemID)
+				input.AfterID = &afterID
+			}
+			var positionMutation struct {
+				UpdateProjectV2ItemPosition struct {
+					ClientMutationID *githubv4.String
+				} `graphql:"updateProjectV2ItemPosition(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &positionMutation, input, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to update project item position", err), nil
+			}
+
+			response := map[string]interface{}{
+				"success":    true,
+				"message":    "Item moved to the top",
+				"project_id": params.ProjectID,
+				"item_id":    params.ItemID,
+			}
+			if params.AfterItemID != "" {
+				response["message"] = fmt.Sprintf("Item moved after %s", params.AfterItemID)
+				response["after_item_id"] = params.AfterItemID
+			}
 
-		// UNDERSTANDING: Return success response with unlinking details
-		// INTEGRATION: Consistent with other MCP tool success responses
-		// VERIFIED: Only repository details available in mutation payload
-		response := map[string]interface{}{
-			"success":         true,
-			"message":         "Project successfully unlinked from repository",
-			"project_id":      params.ProjectID, // Use input parameter since not returned
-			"repository_id":   unlinkProjectMutation.UnlinkProjectV2FromRepository.Repository.ID,
-			"repository_name": unlinkProjectMutation.UnlinkProjectV2FromRepository.Repository.Name,
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
 
-		responseJSON, err := json.Marshal(response)
-		if err != nil {
-			return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+// projectStatusUpdateStatuses lists the health values a project status update can report.
+var projectStatusUpdateStatuses = []string{"INACTIVE", "ON_TRACK", "AT_RISK", "OFF_TRACK", "COMPLETE"}
+
+// UNDERSTANDING: Posts a status update (health, dates and a note) to a project, as shown in its status panel
+// EXPECTS: project_id and status (one of projectStatusUpdateStatuses); start_date, target_date and body optional
+// RETURNS: The new status update's id and url, plus the status and dates that were stored
+// INTEGRATION: Status updates have no URL of their own, so url is the project page that displays them
+func CreateProjectStatusUpdate(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("create_project_status_update",
+			mcp.WithDescription(t("TOOL_CREATE_PROJECT_STATUS_UPDATE_DESCRIPTION", "Post a status update to a GitHub Projects v2 project, reporting whether it is on track, at risk, off track, inactive or complete, with optional start and target dates and a markdown note. The update becomes the project's current status.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_CREATE_PROJECT_STATUS_UPDATE_USER_TITLE", "Create project status update"),
+				ReadOnlyHint: ToBoolPtr(false),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithString("status",
+				mcp.Required(),
+				mcp.Description("Health of the project"),
+				mcp.Enum(projectStatusUpdateStatuses...),
+			),
+			mcp.WithString("start_date",
+				mcp.Description("Start date of the project (YYYY-MM-DD)"),
+			),
+			mcp.WithString("target_date",
+				mcp.Description("Target date of the project (YYYY-MM-DD)"),
+			),
+			mcp.WithString("body",
+				mcp.Description("Markdown body of the status update"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID  string `mapstructure:"project_id"`
+				Status     string `mapstructure:"status"`
+				StartDate  string `mapstructure:"start_date"`
+				TargetDate string `mapstructure:"target_date"`
+				Body       string `mapstructure:"body"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			params.Status = strings.ToUpper(strings.TrimSpace(params.Status))
+			if !slices.Contains(projectStatusUpdateStatuses, params.Status) {
+				return mcp.NewToolResultError(fmt.Sprintf("unsupported status %q: expected one of %s", params.Status, strings.Join(projectStatusUpdateStatuses, ", "))), nil
+			}
+
+			status := githubv4.ProjectV2StatusUpdateStatus(params.Status)
+			input := githubv4.CreateProjectV2StatusUpdateInput{
+				ProjectID: githubv4.ID(params.ProjectID),
+				Status:    &status,
+			}
+			parseDate := func(name, value string) (*githubv4.Date, error) {
+				if value == "" {
+					return nil, nil
+				}
+				parsed, err := time.Parse("2006-01-02", strings.TrimSpace(value))
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s %q: expected YYYY-MM-DD", name, value)
+				}
+				return &githubv4.Date{Time: parsed}, nil
+			}
+			var err error
+			if input.StartDate, err = parseDate("start_date", params.StartDate); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if input.TargetDate, err = parseDate("target_date", params.TargetDate); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			if input.StartDate != nil && input.TargetDate != nil && input.TargetDate.Before(input.StartDate.Time) {
+				return mcp.NewToolResultError("target_date must not be before start_date"), nil
+			}
+			if params.Body != "" {
+				input.Body = githubv4.NewString(githubv4.String(params.Body))
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var createStatusUpdateMutation struct {
+				CreateProjectV2StatusUpdate struct {
+					StatusUpdate struct {
+						ID         githubv4.ID
+						Status     githubv4.String
+						StartDate  *githubv4.String
+						TargetDate *githubv4.String
+						Project    struct {
+							URL githubv4.String
+						}
+					}
+				} `graphql:"createProjectV2StatusUpdate(input: $input)"`
+			}
+			if err := client.Mutate(ctx, &createStatusUpdateMutation, input, nil); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to create project status update", err), nil
+			}
+
+			statusUpdate := createStatusUpdateMutation.CreateProjectV2StatusUpdate.StatusUpdate
+			response := map[string]interface{}{
+				"success":          true,
+				"status_update_id": statusUpdate.ID,
+				"url":              statusUpdate.Project.URL,
+				"project_id":       params.ProjectID,
+				"status":           statusUpdate.Status,
+			}
+			if statusUpdate.StartDate != nil {
+				response["start_date"] = *statusUpdate.StartDate
+			}
+			if statusUpdate.TargetDate != nil {
+				response["target_date"] = *statusUpdate.TargetDate
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
 		}
+}
 
-		return mcp.NewToolResultText(string(responseJSON)), nil
-	}
-}
\ No newline at end of file
+// projectHealthUpdate is one status update posted to a project, as shown in its status panel.
+type projectHealthUpdate struct {
+	ID         githubv4.ID       `json:"id"`
+	Status     *githubv4.String  `json:"status"`
+	Body       githubv4.String   `json:"body"`
+	StartDate  *githubv4.String  `json:"start_date"`
+	TargetDate *githubv4.String  `json:"target_date"`
+	CreatedAt  githubv4.DateTime `json:"created_at"`
+}
+
+// projectStatusUpdatesQuery lists a page of a project's status updates, newest first.
+type projectStatusUpdatesQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			ID            githubv4.ID
+			StatusUpdates struct {
+				Nodes      []projectHealthUpdate
+				TotalCount githubv4.Int
+				PageInfo   struct {
+					HasNextPage githubv4.Boolean
+					EndCursor   githubv4.String
+				}
+			} `graphql:"statusUpdates(first: $first, after: $after, orderBy: {field: CREATED_AT, direction: DESC})"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $id)"`
+}
+
+// UNDERSTANDING: Read a project's status update history to summarize how its health changed over time
+// EXPECTS: project_id, optional first (default 10, max 100) and after cursor
+// RETURNS: Each update's status, body, start and target dates and creation time, newest first, with page info
+// INTEGRATION: Updates posted with create_project_status_update show up here; status is null when none was set
+func ListProjectStatusUpdates(getGQLClient GetGQLClientFn, t translations.TranslationHelperFunc) (mcp.Tool, server.ToolHandlerFunc) {
+	return mcp.NewTool("list_project_status_updates",
+			mcp.WithDescription(t("TOOL_LIST_PROJECT_STATUS_UPDATES_DESCRIPTION", "List the status updates posted to a GitHub Projects v2 project, newest first, with each update's status (on track, at risk, ...), body, start and target dates and creation time.")),
+			mcp.WithToolAnnotation(mcp.ToolAnnotation{
+				Title:        t("TOOL_LIST_PROJECT_STATUS_UPDATES_USER_TITLE", "List project status updates"),
+				ReadOnlyHint: ToBoolPtr(true),
+			}),
+			mcp.WithString("project_id",
+				mcp.Required(),
+				mcp.Description("GitHub Projects v2 project ID"),
+			),
+			mcp.WithNumber("first",
+				mcp.Description("Number of status updates to retrieve (default: 10, max: 100)"),
+			),
+			mcp.WithString("after",
+				mcp.Description("Cursor for the next page: pass the end_cursor of the previous response while has_next_page is true"),
+			),
+		),
+		func(ctx context.Context, request mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var params struct {
+				ProjectID string `mapstructure:"project_id"`
+				First     *int   `mapstructure:"first"`
+				After     string `mapstructure:"after"`
+			}
+			if err := decodeProjectParams(request, &params); err != nil {
+				return mcp.NewToolResultError(err.Error()), nil
+			}
+			first := 10
+			if params.First != nil {
+				first = max(1, min(*params.First, 100))
+			}
+
+			client, err := getGQLClient(ctx)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to get GitHub GQL client: %v", err)), nil
+			}
+
+			var after *githubv4.String
+			if params.After != "" {
+				after = githubv4.NewString(githubv4.String(params.After))
+			}
+			var query projectStatusUpdatesQuery
+			if err := client.Query(ctx, &query, map[string]interface{}{
+				"id":    githubv4.ID(params.ProjectID),
+				"first": githubv4.Int(first), // #nosec G115 - clamped to 100
+				"after": after,
+			}); err != nil {
+				return projectGraphQLErrorResult(ctx, "failed to get project status updates", err), nil
+			}
+			if query.Node.ProjectV2.ID == nil {
+				return mcp.NewToolResultError(fmt.Sprintf("project %s not found", params.ProjectID)), nil
+			}
+
+			statusUpdates := query.Node.ProjectV2.StatusUpdates
+			if statusUpdates.Nodes == nil {
+				statusUpdates.Nodes = []projectHealthUpdate{}
+			}
+			response := map[string]interface{}{
+				"project_id":     params.ProjectID,
+				"status_updates": statusUpdates.Nodes,
+				"total_count":    int(statusUpdates.TotalCount),
+				"has_next_page":  bool(statusUpdates.PageInfo.HasNextPage),
+				"end_cursor":     statusUpdates.PageInfo.EndCursor,
+			}
+
+			responseJSON, err := json.Marshal(response)
+			if err != nil {
+				return mcp.NewToolResultError(fmt.Sprintf("failed to marshal response: %v", err)), nil
+			}
+
+			return mcp.NewToolResultText(string(responseJSON)), nil
+		}
+}
@@ -189,14 +189,85 @@ func DefaultToolsetGroup(readOnly bool, getClient GetClientFn, getGQLClient GetG
 
 	projects := toolsets.NewToolset("projects", "GitHub Projects v2 related tools for project board management").
 		AddReadTools(
-			toolsets.NewServerTool(ListUserProjects(getGQLClient, t)),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListUserProjects(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectFlowMetrics(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListProjectItems(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectFieldUsage(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectItemVisibleViews(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectItemAndContentIDs(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListProjectItemsWithAddedDate(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListProjectsForOwners(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectDescription(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(FindStaleProjectItems(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectItemsByRepository(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListProjectItemsPage(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectStatusOrder(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(FindMultiProjectItems(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ExportProject(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListRecentlyCompletedProjectItems(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectItemChecklistProgress(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectAssigneeWorkload(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectFields(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectsViewerPermissions(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(FindProjectItemsWithoutPR(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectIterationBurndown(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectField(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(DiffProjectSchemas(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListProjectItemsDueSoon(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectItem(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetRepositoryProjectID(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListProjectViews(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProject(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectItemIndex(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListOrgProjects(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectItemDevLinks(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(GetProjectItemStatus(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(FindProjectItemsWithInvalidRepo(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(PlanProjectViewCopy(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ListProjectStatusUpdates(getGQLClient, t))),
 		).
 		AddWriteTools(
-			toolsets.NewServerTool(CreateProject(getGQLClient, t)),
-			toolsets.NewServerTool(AddItemToProject(getGQLClient, t)),
-			toolsets.NewServerTool(UpdateProjectItemStatus(getGQLClient, t)),
-			toolsets.NewServerTool(LinkProjectToRepository(getGQLClient, t)),
-			toolsets.NewServerTool(UnlinkProjectFromRepository(getGQLClient, t)),
+			toolsets.NewServerTool(withRawGraphQLResponses(CreateProject(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(AddItemToProject(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(UpdateProjectItemStatus(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(LinkProjectToRepository(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(UnlinkProjectFromRepository(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(CreateProjectFromTemplate(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(UpdateProjectIterationSettings(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(AddDiscussionToProject(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(CopyProjectFieldOptions(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(RenameProjectField(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(SetProjectItemsTextField(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(SetProjectItemSingleSelect(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(DeleteProjectFieldsByPattern(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(DefaultProjectItemStatus(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(SetProjectItemsAssignees(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ValidateProjectItemsAgainstSchema(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(AddRepositoryIssuesToProject(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ImportProject(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(SetProjectItemRepository(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(UpdateProjectItemsStatus(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ApplyProjectStatusMap(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(SetProjectWorkflowEnabled(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(SyncProjectItemStatusToIssueState(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(CreateProjectForRepository(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ClearProjectItemField(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ConvertAllDraftsToIssues(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(UpdateProject(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(AddDraftIssue(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ConvertDraftIssueToIssue(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(ArchiveProjectItem(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(UnarchiveProjectItem(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(DeleteProjectItem(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(AddItemsToProjectWithStatus(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(CreateProjectField(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(UpdateProjectField(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(DeleteProjectField(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(AddItemsToProject(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(SetProjectItemBlockedBy(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(EstimateProjectItemFromSubIssues(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(SetProjectItemPosition(getGQLClient, t))),
+			toolsets.NewServerTool(withRawGraphQLResponses(CreateProjectStatusUpdate(getGQLClient, t))),
 		)
 
 	// Add toolsets to the group